@@ -0,0 +1,66 @@
+// Command tfshopify-export writes the Terraform configuration and the import blocks of
+// the metafield definitions, the metaobject definitions and the pages of an existing shop.
+//
+// The shop is configured with the same environment variables as the provider:
+// SHOPIFY_SHOP, SHOPIFY_API_VERSION, SHOPIFY_API_KEY, SHOPIFY_API_SECRET_KEY and SHOPIFY_ADMIN_API_ACCESS_TOKEN.
+// Without SHOPIFY_ADMIN_API_ACCESS_TOKEN, the access tokens are obtained with the client credentials grant of the app.
+//
+//	tfshopify-export -out ./shop
+//	cd ./shop && terraform init && terraform plan
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/k-yomo/terraform-provider-shopify/internal/exporter"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+)
+
+func main() {
+	var (
+		out             string
+		ownerTypes      string
+		includeAppOwned bool
+	)
+	flag.StringVar(&out, "out", ".", "directory to write the .tf files into")
+	flag.StringVar(&ownerTypes, "owner-types", strings.Join(exporter.DefaultOwnerTypes, ","), "comma-separated owner types of the metafield definitions to export")
+	flag.BoolVar(&includeAppOwned, "include-app-owned", false, "export the definitions owned by apps, e.g. with the app-- namespace")
+	flag.Parse()
+
+	shop := os.Getenv("SHOPIFY_SHOP")
+	apiVersion := os.Getenv("SHOPIFY_API_VERSION")
+	adminAPIAccessToken := os.Getenv("SHOPIFY_ADMIN_API_ACCESS_TOKEN")
+	app := goshopify.App{
+		ApiKey:    os.Getenv("SHOPIFY_API_KEY"),
+		ApiSecret: os.Getenv("SHOPIFY_API_SECRET_KEY"),
+	}
+	if shop == "" || apiVersion == "" || (adminAPIAccessToken == "" && (app.ApiKey == "" || app.ApiSecret == "")) {
+		log.Fatal("SHOPIFY_SHOP, SHOPIFY_API_VERSION and either SHOPIFY_ADMIN_API_ACCESS_TOKEN or SHOPIFY_API_KEY and SHOPIFY_API_SECRET_KEY must be set")
+	}
+	opts := []goshopify.Option{goshopify.WithVersion(apiVersion)}
+	if adminAPIAccessToken == "" {
+		tokenSource := shopify.NewClientCredentialsTokenSource(http.DefaultClient, shop, app.ApiKey, app.ApiSecret)
+		opts = append(opts, goshopify.WithHTTPClient(&http.Client{
+			Transport: shopify.NewAccessTokenTransport(tokenSource, http.DefaultTransport),
+		}))
+	}
+	shopifyRawClient, err := goshopify.NewClient(app, shop, adminAPIAccessToken, opts...)
+	if err != nil {
+		log.Fatalf("failed to create Shopify client: %v", err)
+	}
+
+	e := exporter.New(
+		shopify.NewClient(shopifyRawClient),
+		exporter.WithOwnerTypes(strings.Split(ownerTypes, ",")),
+		exporter.WithAppOwned(includeAppOwned),
+	)
+	if err := e.Export(context.Background(), out); err != nil {
+		log.Fatalf("failed to export the shop: %v", err)
+	}
+}
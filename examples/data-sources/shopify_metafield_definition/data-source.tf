@@ -0,0 +1,5 @@
+data "shopify_metafield_definition" "example" {
+  owner_type = "PRODUCT"
+  namespace  = "custom"
+  key        = "material"
+}
@@ -0,0 +1,3 @@
+data "shopify_metaobject_definition" "example" {
+  type = "designer"
+}
@@ -0,0 +1,7 @@
+resource "shopify_metafield" "about_page" {
+  owner_id  = "gid://shopify/Shop/1"
+  namespace = "custom"
+  key       = "about_page"
+  type      = "page_reference"
+  value     = provider::shopify::gid_build("Page", shopify_page.about.id)
+}
@@ -0,0 +1,4 @@
+# Returns "123"
+output "page_id" {
+  value = provider::shopify::gid_parse("gid://shopify/Page/123")
+}
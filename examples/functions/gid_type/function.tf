@@ -0,0 +1,4 @@
+# Returns "Page"
+output "resource_type" {
+  value = provider::shopify::gid_type("gid://shopify/Page/123")
+}
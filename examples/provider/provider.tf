@@ -5,3 +5,13 @@ provider "shopify" {
   api_secret_key         = "XXXXXXXXXXXXXX"
   admin_api_access_token = "shpat_XXXXXXXXXXXXX"
 }
+
+# Without admin_api_access_token, access tokens are obtained and refreshed
+# with the client credentials grant of the app.
+provider "shopify" {
+  alias          = "client_credentials"
+  shop           = "shop-name.myshopify.com"
+  api_version    = "2024-01"
+  api_key        = "XXXXXXXXXXXXXX"
+  api_secret_key = "XXXXXXXXXXXXXX"
+}
@@ -0,0 +1,2 @@
+# Note: integer ids instead of graphql global ids
+terraform import shopify_article.example {{blog_id}}/{{article_id}}
\ No newline at end of file
@@ -0,0 +1,18 @@
+resource "shopify_blog" "example" {
+  handle = "news"
+  title  = "News"
+}
+
+resource "shopify_article" "example" {
+  blog_id   = shopify_blog.example.id
+  handle    = "summer-sale"
+  author    = "Tom Brown"
+  title     = "Summer Sale"
+  body_html = "<p>Our summer sale starts today!</p>"
+  tags      = ["sale", "summer"]
+  published = true
+  image = {
+    src = "https://example.com/images/summer-sale.png"
+    alt = "Summer Sale"
+  }
+}
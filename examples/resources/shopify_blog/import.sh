@@ -0,0 +1,2 @@
+# Note: integer id instead of graphql global id
+terraform import shopify_blog.example {{id}}
\ No newline at end of file
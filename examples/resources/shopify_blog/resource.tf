@@ -0,0 +1,5 @@
+resource "shopify_blog" "example" {
+  handle      = "news"
+  title       = "News"
+  commentable = "moderate"
+}
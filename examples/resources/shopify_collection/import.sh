@@ -0,0 +1 @@
+terraform import shopify_collection.example {{id}}
\ No newline at end of file
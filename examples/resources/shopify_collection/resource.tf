@@ -0,0 +1,40 @@
+# Custom collection
+resource "shopify_collection" "custom" {
+  title            = "Summer Collection"
+  handle           = "summer-collection"
+  description_html = "<p>Our summer collection</p>"
+  sort_order       = "MANUAL"
+  seo = {
+    title       = "Summer Collection"
+    description = "Products for the summer"
+  }
+}
+
+# Smart collection
+resource "shopify_metafield_definition" "material" {
+  key        = "material"
+  name       = "Material"
+  namespace  = "custom"
+  owner_type = "PRODUCT"
+  type       = "single_line_text_field"
+}
+
+resource "shopify_collection" "smart" {
+  title = "Cotton Products"
+  rule_set = {
+    applied_disjunctively = false
+    rules = [
+      {
+        column    = "TYPE"
+        relation  = "EQUALS"
+        condition = "Shirts"
+      },
+      {
+        column              = "PRODUCT_METAFIELD_DEFINITION"
+        relation            = "EQUALS"
+        condition           = "Cotton"
+        condition_object_id = shopify_metafield_definition.material.id
+      },
+    ]
+  }
+}
@@ -0,0 +1 @@
+terraform import shopify_menu.example {{id}}
\ No newline at end of file
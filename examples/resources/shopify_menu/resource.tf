@@ -0,0 +1,34 @@
+resource "shopify_page" "about" {
+  handle    = "about-us"
+  author    = "Tom Brown"
+  title     = "About us"
+  body_html = "<p>About us</p>"
+}
+
+resource "shopify_menu" "example" {
+  handle = "header-menu"
+  title  = "Header menu"
+  items = [
+    {
+      title = "Home"
+      type  = "FRONTPAGE"
+    },
+    {
+      title       = "About us"
+      type        = "PAGE"
+      resource_id = "gid://shopify/Page/${shopify_page.about.id}"
+    },
+    {
+      title = "Links"
+      type  = "HTTP"
+      url   = "https://example.com"
+      items = [
+        {
+          title = "Blog"
+          type  = "HTTP"
+          url   = "https://example.com/blog"
+        },
+      ]
+    },
+  ]
+}
@@ -0,0 +1 @@
+terraform import shopify_metafield.example gid://shopify/Metafield/{{id}}
\ No newline at end of file
@@ -0,0 +1,21 @@
+resource "shopify_product" "example" {
+  title = "Example"
+}
+
+resource "shopify_metafield" "care_guide" {
+  owner_id  = shopify_product.example.id
+  namespace = "custom"
+  key       = "care_guide"
+  type      = "multi_line_text_field"
+  value     = "Machine wash cold."
+}
+
+resource "shopify_metafield" "theme_config" {
+  owner_id  = shopify_product.example.id
+  namespace = "custom"
+  key       = "theme_config"
+  type      = "json"
+  value = jsonencode({
+    show_banner = true
+  })
+}
@@ -1 +1,4 @@
-terraform import shopify_metafield_definition.test gid://shopify/MetafieldDefinition/{{id}}
\ No newline at end of file
+terraform import shopify_metafield_definition.test gid://shopify/MetafieldDefinition/{{id}}
+
+# The definition can also be imported by owner type, namespace and key
+terraform import shopify_metafield_definition.test PRODUCT/custom/care_guide
@@ -4,4 +4,12 @@ resource "shopify_metafield_definition" "example" {
   namespace  = "custom"
   owner_type = "CUSTOMER"
   type       = "single_line_text_field"
+
+  access = {
+    storefront       = "PUBLIC_READ"
+    customer_account = "READ"
+  }
+  capabilities = {
+    admin_filterable = true
+  }
 }
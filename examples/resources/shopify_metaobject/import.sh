@@ -0,0 +1 @@
+terraform import shopify_metaobject.example gid://shopify/Metaobject/{{id}}
\ No newline at end of file
@@ -0,0 +1,26 @@
+resource "shopify_metaobject_definition" "store_location" {
+  name = "Store Location"
+  type = "store_location"
+  field_definitions = [
+    {
+      key      = "name"
+      name     = "Name"
+      type     = "single_line_text_field"
+      required = true
+    },
+    {
+      key  = "address"
+      name = "Address"
+      type = "multi_line_text_field"
+    }
+  ]
+}
+
+resource "shopify_metaobject" "tokyo" {
+  type   = shopify_metaobject_definition.store_location.type
+  handle = "tokyo"
+  fields = {
+    name    = "Tokyo"
+    address = "1-1-1 Shibuya, Tokyo"
+  }
+}
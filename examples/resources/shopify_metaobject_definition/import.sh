@@ -1 +1,4 @@
-terraform import shopify_metaobject_definition.example gid://shopify/MetaobjectDefinition/{{id}}
\ No newline at end of file
+terraform import shopify_metaobject_definition.example gid://shopify/MetaobjectDefinition/{{id}}
+
+# The definition can also be imported by type
+terraform import shopify_metaobject_definition.example author
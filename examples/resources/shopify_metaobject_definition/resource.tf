@@ -20,4 +20,13 @@ resource "shopify_metaobject_definition" "example" {
       type = "rich_text_field"
     }
   ]
+  capabilities = {
+    publishable = true
+    renderable = {
+      meta_title_key = "text_field"
+    }
+    online_store = {
+      url_handle = "examples"
+    }
+  }
 }
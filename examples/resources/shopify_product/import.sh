@@ -0,0 +1 @@
+terraform import shopify_product.example gid://shopify/Product/{{id}}
\ No newline at end of file
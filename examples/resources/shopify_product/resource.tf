@@ -0,0 +1,26 @@
+resource "shopify_product" "example" {
+  title        = "Example T-Shirt"
+  handle       = "example-t-shirt"
+  status       = "ACTIVE"
+  vendor       = "Example Vendor"
+  product_type = "T-Shirts"
+  tags         = ["summer", "cotton"]
+  options = [
+    {
+      name   = "Size"
+      values = ["S", "M"]
+    }
+  ]
+  variants = [
+    {
+      option_values = { Size = "S" }
+      price         = "19.99"
+      sku           = "EXAMPLE-S"
+    },
+    {
+      option_values = { Size = "M" }
+      price         = "19.99"
+      sku           = "EXAMPLE-M"
+    }
+  ]
+}
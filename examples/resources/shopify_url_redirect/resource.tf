@@ -0,0 +1,4 @@
+resource "shopify_url_redirect" "example" {
+  path   = "/pages/old-about-us"
+  target = "/pages/about-us"
+}
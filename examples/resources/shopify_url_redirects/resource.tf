@@ -0,0 +1,7 @@
+resource "shopify_url_redirects" "migration" {
+  redirects = {
+    "/old-shop/t-shirts" = "/collections/t-shirts"
+    "/old-shop/hoodies"  = "/collections/hoodies"
+    "/old-shop/contact"  = "/pages/contact"
+  }
+}
@@ -0,0 +1 @@
+terraform import shopify_webhook_subscription.example gid://shopify/WebhookSubscription/{{id}}
\ No newline at end of file
@@ -0,0 +1,16 @@
+resource "shopify_webhook_subscription" "orders_create" {
+  topic          = "ORDERS_CREATE"
+  callback_url   = "https://example.com/webhooks/orders/create"
+  include_fields = ["id", "email", "total_price"]
+}
+
+resource "shopify_webhook_subscription" "products_update" {
+  topic            = "PRODUCTS_UPDATE"
+  event_bridge_arn = "arn:aws:events:us-east-1::event-source/aws.partner/shopify.com/1234567/example"
+}
+
+resource "shopify_webhook_subscription" "customers_create" {
+  topic           = "CUSTOMERS_CREATE"
+  pub_sub_project = "example-project"
+  pub_sub_topic   = "shopify-customers"
+}
@@ -0,0 +1,376 @@
+// Package exporter generates the Terraform configuration and the import blocks of the resources of an existing shop,
+// so that the shop can be brought under management with a single `terraform apply`.
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// DefaultOwnerTypes are the owner types of the metafield definitions exported by default.
+var DefaultOwnerTypes = []string{
+	"ARTICLE",
+	"BLOG",
+	"COLLECTION",
+	"COMPANY",
+	"COMPANY_LOCATION",
+	"CUSTOMER",
+	"DRAFTORDER",
+	"LOCATION",
+	"MARKET",
+	"ORDER",
+	"PAGE",
+	"PRODUCT",
+	"PRODUCTVARIANT",
+	"SHOP",
+}
+
+// providerSource is the source address of the provider in the Terraform registry.
+const providerSource = "k-yomo/shopify"
+
+// appOwnedPrefix is the prefix of the namespaces and types reserved for apps.
+// Definitions owned by apps can't be managed with the merchant's access token.
+const appOwnedPrefix = "app--"
+
+type Exporter struct {
+	client          *shopify.Client
+	ownerTypes      []string
+	includeAppOwned bool
+}
+
+type Option func(e *Exporter)
+
+// WithOwnerTypes sets the owner types of the metafield definitions to export.
+func WithOwnerTypes(ownerTypes []string) Option {
+	return func(e *Exporter) {
+		e.ownerTypes = ownerTypes
+	}
+}
+
+// WithAppOwned sets whether to export the definitions owned by apps.
+func WithAppOwned(includeAppOwned bool) Option {
+	return func(e *Exporter) {
+		e.includeAppOwned = includeAppOwned
+	}
+}
+
+func New(client *shopify.Client, opts ...Option) *Exporter {
+	e := &Exporter{
+		client:     client,
+		ownerTypes: DefaultOwnerTypes,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// Export writes the resources and the import blocks of the shop into the directory.
+// Each resource type is written into its own file, all the import blocks are written into imports.tf
+// and the provider requirement is written into providers.tf so that the directory can be planned as is.
+func (e *Exporter) Export(ctx context.Context, dir string) error {
+	imports := hclwrite.NewEmptyFile()
+	names := map[string]map[string]bool{}
+	uniqueName := func(resourceType string, parts ...string) string {
+		if names[resourceType] == nil {
+			names[resourceType] = map[string]bool{}
+		}
+		name := resourceName(parts...)
+		for i := 2; names[resourceType][name]; i++ {
+			name = fmt.Sprintf("%s_%d", resourceName(parts...), i)
+		}
+		names[resourceType][name] = true
+		return name
+	}
+	appendImport := func(resourceType, name, id string) {
+		block := imports.Body().AppendNewBlock("import", nil)
+		block.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: resourceType},
+			hcl.TraverseAttr{Name: name},
+		})
+		block.Body().SetAttributeValue("id", cty.StringVal(id))
+		imports.Body().AppendNewline()
+	}
+
+	metafieldDefinitions := hclwrite.NewEmptyFile()
+	for _, ownerType := range e.ownerTypes {
+		definitions, err := e.client.ListMetafieldDefinitions(ctx, ownerType)
+		if err != nil {
+			return fmt.Errorf("list metafield definitions of %s: %w", ownerType, err)
+		}
+		for _, definition := range definitions {
+			if !e.includeAppOwned && strings.HasPrefix(definition.Namespace, appOwnedPrefix) {
+				continue
+			}
+			name := uniqueName("shopify_metafield_definition", definition.OwnerType, definition.Namespace, definition.Key)
+			appendMetafieldDefinition(metafieldDefinitions.Body(), name, definition)
+			appendImport("shopify_metafield_definition", name, definition.ID)
+		}
+	}
+
+	metaobjectDefinitions := hclwrite.NewEmptyFile()
+	definitions, err := e.client.ListMetaobjectDefinitions(ctx)
+	if err != nil {
+		return fmt.Errorf("list metaobject definitions: %w", err)
+	}
+	for _, definition := range definitions {
+		if !e.includeAppOwned && strings.HasPrefix(definition.Type, appOwnedPrefix) {
+			continue
+		}
+		name := uniqueName("shopify_metaobject_definition", definition.Type)
+		appendMetaobjectDefinition(metaobjectDefinitions.Body(), name, definition)
+		appendImport("shopify_metaobject_definition", name, definition.ID)
+	}
+
+	pages := hclwrite.NewEmptyFile()
+	shopPages, err := e.client.ListPages(ctx)
+	if err != nil {
+		return fmt.Errorf("list pages: %w", err)
+	}
+	for i := range shopPages {
+		page := &shopPages[i]
+		name := uniqueName("shopify_page", page.Handle)
+		appendPage(pages.Body(), name, page)
+		appendImport("shopify_page", name, strconv.FormatUint(page.Id, 10))
+	}
+
+	files := map[string]*hclwrite.File{
+		"providers.tf":              providers(),
+		"metafield_definitions.tf":  metafieldDefinitions,
+		"metaobject_definitions.tf": metaobjectDefinitions,
+		"pages.tf":                  pages,
+		"imports.tf":                imports,
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for filename, file := range files {
+		if len(file.Body().Blocks()) == 0 {
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(dir, filename), hclwrite.Format(file.Bytes()), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// providers returns the file requiring the provider, along with the version of Terraform supporting import blocks.
+func providers() *hclwrite.File {
+	file := hclwrite.NewEmptyFile()
+	b := file.Body().AppendNewBlock("terraform", nil).Body()
+	b.SetAttributeValue("required_version", cty.StringVal(">= 1.5.0"))
+	b.AppendNewBlock("required_providers", nil).Body().SetAttributeValue("shopify", cty.ObjectVal(map[string]cty.Value{
+		"source": cty.StringVal(providerSource),
+	}))
+	return file
+}
+
+func appendMetafieldDefinition(body *hclwrite.Body, name string, definition *shopify.MetafieldDefinition) {
+	b := body.AppendNewBlock("resource", []string{"shopify_metafield_definition", name}).Body()
+	b.SetAttributeValue("name", cty.StringVal(definition.Name))
+	if definition.Description != "" {
+		b.SetAttributeValue("description", cty.StringVal(definition.Description))
+	}
+	b.SetAttributeValue("owner_type", cty.StringVal(definition.OwnerType))
+	b.SetAttributeValue("namespace", cty.StringVal(definition.Namespace))
+	b.SetAttributeValue("key", cty.StringVal(definition.Key))
+	b.SetAttributeValue("type", cty.StringVal(definition.Type.Name))
+	if definition.PinnedPosition != nil {
+		b.SetAttributeValue("pin", cty.True)
+	}
+	if len(definition.Validations) > 0 {
+		b.SetAttributeValue("validations", validationsValue(definition.Validations))
+	}
+	if access, ok := metafieldAccessValue(definition.Access); ok {
+		b.SetAttributeValue("access", access)
+	}
+	if capabilities, ok := metafieldCapabilitiesValue(definition.Capabilities); ok {
+		b.SetAttributeValue("capabilities", capabilities)
+	}
+	body.AppendNewline()
+}
+
+// metafieldAccessValue returns the storefront and customer account access granted to the metafields.
+// The admin access is left out since it can only be set in the namespaces reserved by apps.
+func metafieldAccessValue(access *shopify.MetafieldAccess) (cty.Value, bool) {
+	if access == nil {
+		return cty.NilVal, false
+	}
+	attrs := map[string]cty.Value{}
+	if access.Storefront != "" && access.Storefront != "NONE" {
+		attrs["storefront"] = cty.StringVal(access.Storefront)
+	}
+	if access.CustomerAccount != "" && access.CustomerAccount != "NONE" {
+		attrs["customer_account"] = cty.StringVal(access.CustomerAccount)
+	}
+	if len(attrs) == 0 {
+		return cty.NilVal, false
+	}
+	return cty.ObjectVal(attrs), true
+}
+
+// metafieldCapabilitiesValue returns the enabled capabilities of the metafield definition.
+func metafieldCapabilitiesValue(capabilities *shopify.MetafieldCapabilities) (cty.Value, bool) {
+	if capabilities == nil {
+		return cty.NilVal, false
+	}
+	attrs := map[string]cty.Value{}
+	if capabilities.AdminFilterable != nil && capabilities.AdminFilterable.Enabled {
+		attrs["admin_filterable"] = cty.True
+	}
+	if capabilities.SmartCollectionCondition != nil && capabilities.SmartCollectionCondition.Enabled {
+		attrs["smart_collection_condition"] = cty.True
+	}
+	if len(attrs) == 0 {
+		return cty.NilVal, false
+	}
+	return cty.ObjectVal(attrs), true
+}
+
+// capabilitiesValue returns the enabled capabilities. It returns false if none is enabled
+// not to manage the capabilities of the definitions which don't use them.
+func capabilitiesValue(capabilities *shopify.MetaobjectDefinitionCapabilities) (cty.Value, bool) {
+	if capabilities == nil {
+		return cty.NilVal, false
+	}
+	attrs := map[string]cty.Value{}
+	if capabilities.Publishable.Enabled {
+		attrs["publishable"] = cty.True
+	}
+	if capabilities.Translatable.Enabled {
+		attrs["translatable"] = cty.True
+	}
+	if capabilities.Renderable.Enabled {
+		renderable := map[string]cty.Value{}
+		if data := capabilities.Renderable.Data; data != nil {
+			if data.MetaTitleKey != nil {
+				renderable["meta_title_key"] = cty.StringVal(*data.MetaTitleKey)
+			}
+			if data.MetaDescriptionKey != nil {
+				renderable["meta_description_key"] = cty.StringVal(*data.MetaDescriptionKey)
+			}
+		}
+		attrs["renderable"] = cty.ObjectVal(renderable)
+	}
+	if capabilities.OnlineStore.Enabled && capabilities.OnlineStore.Data != nil {
+		onlineStore := map[string]cty.Value{
+			"url_handle": cty.StringVal(capabilities.OnlineStore.Data.URLHandle),
+		}
+		if capabilities.OnlineStore.Data.CanCreateRedirects {
+			onlineStore["create_redirects"] = cty.True
+		}
+		attrs["online_store"] = cty.ObjectVal(onlineStore)
+	}
+	if len(attrs) == 0 {
+		return cty.NilVal, false
+	}
+	return cty.ObjectVal(attrs), true
+}
+
+func appendMetaobjectDefinition(body *hclwrite.Body, name string, definition *shopify.MetaobjectDefinition) {
+	b := body.AppendNewBlock("resource", []string{"shopify_metaobject_definition", name}).Body()
+	b.SetAttributeValue("type", cty.StringVal(definition.Type))
+	b.SetAttributeValue("name", cty.StringVal(definition.Name))
+	if definition.Description != "" {
+		b.SetAttributeValue("description", cty.StringVal(definition.Description))
+	}
+	if definition.DisplayNameKey != nil && *definition.DisplayNameKey != "" {
+		b.SetAttributeValue("display_name_key", cty.StringVal(*definition.DisplayNameKey))
+	}
+	if definition.Access != nil {
+		access := map[string]cty.Value{}
+		if definition.Access.Admin != "" {
+			access["admin"] = cty.StringVal(definition.Access.Admin)
+		}
+		if definition.Access.Storefront != "" {
+			access["storefront"] = cty.StringVal(definition.Access.Storefront)
+		}
+		if len(access) > 0 {
+			b.SetAttributeValue("access", cty.ObjectVal(access))
+		}
+	}
+	if capabilities, ok := capabilitiesValue(definition.Capabilities); ok {
+		b.SetAttributeValue("capabilities", capabilities)
+	}
+
+	fieldDefinitions := make([]cty.Value, 0, len(definition.FieldDefinitions))
+	for _, fieldDefinition := range definition.FieldDefinitions {
+		attrs := map[string]cty.Value{
+			"key":  cty.StringVal(fieldDefinition.Key),
+			"type": cty.StringVal(fieldDefinition.Type.Name),
+		}
+		if fieldDefinition.Name != "" {
+			attrs["name"] = cty.StringVal(fieldDefinition.Name)
+		}
+		if fieldDefinition.Description != "" {
+			attrs["description"] = cty.StringVal(fieldDefinition.Description)
+		}
+		if fieldDefinition.Required {
+			attrs["required"] = cty.True
+		}
+		if len(fieldDefinition.Validations) > 0 {
+			attrs["validations"] = validationsValue(fieldDefinition.Validations)
+		}
+		fieldDefinitions = append(fieldDefinitions, cty.ObjectVal(attrs))
+	}
+	b.SetAttributeValue("field_definitions", tupleVal(fieldDefinitions))
+	body.AppendNewline()
+}
+
+func appendPage(body *hclwrite.Body, name string, page *goshopify.Page) {
+	b := body.AppendNewBlock("resource", []string{"shopify_page", name}).Body()
+	b.SetAttributeValue("handle", cty.StringVal(page.Handle))
+	b.SetAttributeValue("title", cty.StringVal(page.Title))
+	b.SetAttributeValue("author", cty.StringVal(page.Author))
+	b.SetAttributeValue("body_html", cty.StringVal(page.BodyHTML))
+	if page.TemplateSuffix != "" {
+		b.SetAttributeValue("template_suffix", cty.StringVal(page.TemplateSuffix))
+	}
+	if page.PublishedAt != nil {
+		b.SetAttributeValue("published", cty.True)
+	}
+	body.AppendNewline()
+}
+
+func validationsValue(validations []*shopify.MetafieldDefinitionValidation) cty.Value {
+	values := make([]cty.Value, 0, len(validations))
+	for _, validation := range validations {
+		values = append(values, cty.ObjectVal(map[string]cty.Value{
+			"name":  cty.StringVal(validation.Name),
+			"value": cty.StringVal(validation.Value),
+		}))
+	}
+	return tupleVal(values)
+}
+
+// tupleVal returns the values as a tuple, since the objects in a list attribute may have different optional attributes.
+func tupleVal(values []cty.Value) cty.Value {
+	if len(values) == 0 {
+		return cty.EmptyTupleVal
+	}
+	return cty.TupleVal(values)
+}
+
+var invalidNameCharRegex = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// resourceName converts the parts into a valid resource name, e.g. PRODUCT, custom and care-guide into product_custom_care_guide.
+func resourceName(parts ...string) string {
+	name := invalidNameCharRegex.ReplaceAllString(strings.ToLower(strings.Join(parts, "_")), "_")
+	name = strings.Trim(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "r_" + name
+	}
+	return name
+}
@@ -0,0 +1,212 @@
+package exporter
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify/shopifytest"
+	"github.com/k-yomo/terraform-provider-shopify/internal/utils"
+)
+
+func newTestClient(t *testing.T) *shopify.Client {
+	t.Helper()
+	server := shopifytest.NewServer()
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shopifyClient, err := goshopify.NewClient(goshopify.App{}, "test", "token", goshopify.WithVersion("2024-07"), goshopify.WithHTTPClient(&http.Client{
+		Transport: utils.NewBaseURLTransport(serverURL, http.DefaultTransport),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return shopify.NewClient(shopifyClient)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestExporter_Export(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	if _, err := client.CreateMetafieldDefinition(ctx, &shopify.MetafieldDefinitionInput{
+		Name:        "Care guide",
+		Description: "How to care for the product",
+		OwnerType:   "PRODUCT",
+		Namespace:   "custom",
+		Key:         "care-guide",
+		Type:        "multi_line_text_field",
+		Pin:         true,
+		Validations: []*shopify.MetafieldDefinitionValidation{{Name: "max", Value: "500"}},
+		Access:      &shopify.MetafieldAccess{Storefront: "PUBLIC_READ"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateMetafieldDefinition(ctx, &shopify.MetafieldDefinitionInput{
+		Name:      "Owned by app",
+		OwnerType: "PRODUCT",
+		Namespace: "app--123--reviews",
+		Key:       "rating",
+		Type:      "rating",
+		Validations: []*shopify.MetafieldDefinitionValidation{
+			{Name: "scale_min", Value: "1.0"},
+			{Name: "scale_max", Value: "5.0"},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateMetaobjectDefinition(ctx, &shopify.MetaobjectDefinitionCreateInput{
+		Type:           "designer",
+		Name:           "Designer",
+		DisplayNameKey: ptr("name"),
+		FieldDefinitions: []*shopify.MetaobjectFieldDefinitionCreateInput{
+			{Key: "name", Name: ptr("Name"), Type: "single_line_text_field", Required: true},
+			{Key: "bio", Type: "multi_line_text_field"},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Page().Create(ctx, goshopify.Page{
+		Title:    "About us",
+		Handle:   "about-us",
+		Author:   "Shop",
+		BodyHTML: "<p>About us</p>",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	if err := New(client, WithOwnerTypes([]string{"PRODUCT"})).Export(ctx, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"providers.tf": `terraform {
+  required_version = ">= 1.5.0"
+  required_providers {
+    shopify = {
+      source = "k-yomo/shopify"
+    }
+  }
+}
+`,
+		"metafield_definitions.tf": `resource "shopify_metafield_definition" "product_custom_care_guide" {
+  name        = "Care guide"
+  description = "How to care for the product"
+  owner_type  = "PRODUCT"
+  namespace   = "custom"
+  key         = "care-guide"
+  type        = "multi_line_text_field"
+  pin         = true
+  validations = [{
+    name  = "max"
+    value = "500"
+  }]
+  access = {
+    storefront = "PUBLIC_READ"
+  }
+}
+
+`,
+		"metaobject_definitions.tf": `resource "shopify_metaobject_definition" "designer" {
+  type             = "designer"
+  name             = "Designer"
+  display_name_key = "name"
+  access = {
+    admin      = "PUBLIC_READ_WRITE"
+    storefront = "NONE"
+  }
+  field_definitions = [{
+    key      = "name"
+    name     = "Name"
+    required = true
+    type     = "single_line_text_field"
+    }, {
+    key  = "bio"
+    type = "multi_line_text_field"
+  }]
+}
+
+`,
+		"pages.tf": `resource "shopify_page" "about_us" {
+  handle    = "about-us"
+  title     = "About us"
+  author    = "Shop"
+  body_html = "<p>About us</p>"
+  published = true
+}
+
+`,
+		"imports.tf": `import {
+  to = shopify_metafield_definition.product_custom_care_guide
+  id = "gid://shopify/MetafieldDefinition/1"
+}
+
+import {
+  to = shopify_metaobject_definition.designer
+  id = "gid://shopify/MetaobjectDefinition/3"
+}
+
+import {
+  to = shopify_page.about_us
+  id = "4"
+}
+
+`,
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]string{}
+	parser := hclparse.NewParser()
+	for _, entry := range entries {
+		b, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, diags := parser.ParseHCL(b, entry.Name()); diags.HasErrors() {
+			t.Errorf("%s is not valid HCL: %s", entry.Name(), diags)
+		}
+		got[entry.Name()] = string(b)
+	}
+	for filename, content := range want {
+		if got[filename] != content {
+			t.Errorf("%s:\ngot:\n%s\nwant:\n%s", filename, got[filename], content)
+		}
+	}
+	for filename := range got {
+		if _, ok := want[filename]; !ok {
+			t.Errorf("unexpected file %s", filename)
+		}
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	tests := []struct {
+		parts []string
+		want  string
+	}{
+		{parts: []string{"PRODUCT", "custom", "care-guide"}, want: "product_custom_care_guide"},
+		{parts: []string{"$app:reviews"}, want: "app_reviews"},
+		{parts: []string{"404"}, want: "r_404"},
+		{parts: []string{"---"}, want: "r_"},
+	}
+	for _, tt := range tests {
+		if got := resourceName(tt.parts...); got != tt.want {
+			t.Errorf("resourceName(%q) = %q, want %q", tt.parts, got, tt.want)
+		}
+	}
+}
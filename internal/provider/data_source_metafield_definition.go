@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MetafieldDefinitionDataSource{}
+
+// MetafieldDefinitionDataSource defines the data source implementation.
+type MetafieldDefinitionDataSource struct {
+	client *shopify.Client
+}
+
+func NewMetafieldDefinitionDataSource() datasource.DataSource {
+	return &MetafieldDefinitionDataSource{}
+}
+
+func (d *MetafieldDefinitionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metafield_definition"
+}
+
+func (d *MetafieldDefinitionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to look up a metafield definition by owner type, namespace and key, e.g. a definition managed by another workspace or by an app.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the metafield definition.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The human-readable name for the metafield definition.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description for the metafield definition.",
+				Computed:            true,
+			},
+			"owner_type": schema.StringAttribute{
+				MarkdownDescription: "The resource type that the metafield definition is attached to, e.g. `PRODUCT`.",
+				Required:            true,
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The container for a group of metafields that the metafield definition is associated with.",
+				Required:            true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier for a metafield within its namespace.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of data that each of the metafields that belong to the metafield definition will store.",
+				Computed:            true,
+			},
+			"pin": schema.BoolAttribute{
+				MarkdownDescription: "Whether the metafield definition is pinned.",
+				Computed:            true,
+			},
+			"validations": schema.ListNestedAttribute{
+				MarkdownDescription: "Custom validations that apply to values assigned to the field.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name for the metafield definition validation.",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The value for the metafield definition validation.",
+							Computed:            true,
+						},
+					},
+				},
+				Computed: true,
+			},
+			"access": schema.SingleNestedAttribute{
+				MarkdownDescription: "The access settings of the metafields under this definition.",
+				Attributes: map[string]schema.Attribute{
+					"admin": schema.StringAttribute{
+						MarkdownDescription: "The admin access setting.",
+						Computed:            true,
+					},
+					"storefront": schema.StringAttribute{
+						MarkdownDescription: "The Storefront API access setting.",
+						Computed:            true,
+					},
+					"customer_account": schema.StringAttribute{
+						MarkdownDescription: "The Customer Account API access setting.",
+						Computed:            true,
+					},
+				},
+				Computed: true,
+			},
+			"capabilities": schema.SingleNestedAttribute{
+				MarkdownDescription: "The capabilities of the metafield definition.",
+				Attributes: map[string]schema.Attribute{
+					"admin_filterable": schema.BoolAttribute{
+						MarkdownDescription: "Whether the metafields can be used to filter the resource list in the Shopify admin.",
+						Computed:            true,
+					},
+					"smart_collection_condition": schema.BoolAttribute{
+						MarkdownDescription: "Whether the metafields can be used as conditions of smart collections.",
+						Computed:            true,
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *MetafieldDefinitionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (d *MetafieldDefinitionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MetafieldDefinitionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	definition, err := d.client.FindMetafieldDefinition(ctx, data.OwnerType.ValueString(), data.Namespace.ValueString(), data.Key.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metafield definition, got error: %s", err))
+		return
+	}
+	if definition == nil {
+		resp.Diagnostics.AddError(
+			"Metafield Definition Not Found",
+			fmt.Sprintf("No metafield definition found for owner type %q, namespace %q and key %q.", data.OwnerType.ValueString(), data.Namespace.ValueString(), data.Key.ValueString()),
+		)
+		return
+	}
+
+	model, diags := convertMetafieldDefinitionToResourceModel(ctx, definition, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
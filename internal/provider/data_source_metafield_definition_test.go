@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMetafieldDefinitionDataSource(t *testing.T) {
+	key := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAllowFake(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccMetafieldDefinitionDataSourceConfig(key),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.shopify_metafield_definition.test", "id", "shopify_metafield_definition.test", "id"),
+					resource.TestCheckResourceAttr("data.shopify_metafield_definition.test", "name", "Test"),
+					resource.TestCheckResourceAttr("data.shopify_metafield_definition.test", "type", "single_line_text_field"),
+					resource.TestCheckResourceAttr("data.shopify_metafield_definition.test", "validations.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetafieldDefinitionDataSourceConfig(key string) string {
+	return fmt.Sprintf(`
+resource "shopify_metafield_definition" "test" {
+  key        = %[1]q
+  name       = "Test"
+  namespace  = "testacc"
+  owner_type = "PRODUCT"
+  type       = "single_line_text_field"
+  validations = [
+    {
+      name  = "max"
+      value = "100"
+    },
+  ]
+}
+
+data "shopify_metafield_definition" "test" {
+  owner_type = shopify_metafield_definition.test.owner_type
+  namespace  = shopify_metafield_definition.test.namespace
+  key        = shopify_metafield_definition.test.key
+}
+`, key)
+}
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MetaobjectDefinitionDataSource{}
+
+// MetaobjectDefinitionDataSource defines the data source implementation.
+type MetaobjectDefinitionDataSource struct {
+	client *shopify.Client
+}
+
+// MetaobjectDefinitionDataSourceModel describes the data source data model.
+// It has the attributes of the resource except the ones only controlling how the resource applies changes.
+type MetaobjectDefinitionDataSourceModel struct {
+	ID                types.String                                `tfsdk:"id"`
+	Name              types.String                                `tfsdk:"name"`
+	Type              types.String                                `tfsdk:"type"`
+	Description       types.String                                `tfsdk:"description"`
+	DisplayNameKey    types.String                                `tfsdk:"display_name_key"`
+	FieldDefinitions  []*MetaobjectFieldDefinitionDataSourceModel `tfsdk:"field_definitions"`
+	HasThumbnailField types.Bool                                  `tfsdk:"has_thumbnail_field"`
+	Access            types.Object                                `tfsdk:"access"`
+	Capabilities      *MetaobjectDefinitionCapabilitiesModel      `tfsdk:"capabilities"`
+}
+
+// MetaobjectFieldDefinitionDataSourceModel describes the metaobject field definition data model of the data source.
+type MetaobjectFieldDefinitionDataSourceModel struct {
+	Key         types.String                          `tfsdk:"key"`
+	Name        types.String                          `tfsdk:"name"`
+	Description types.String                          `tfsdk:"description"`
+	Type        types.String                          `tfsdk:"type"`
+	Required    types.Bool                            `tfsdk:"required"`
+	Validations []*MetafieldDefinitionValidationModel `tfsdk:"validations"`
+}
+
+func NewMetaobjectDefinitionDataSource() datasource.DataSource {
+	return &MetaobjectDefinitionDataSource{}
+}
+
+func (d *MetaobjectDefinitionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metaobject_definition"
+}
+
+func (d *MetaobjectDefinitionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to look up a metaobject definition by its type.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique ID of the metaobject definition.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The human-readable name for the metaobject definition.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the object definition.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description for the metaobject definition.",
+				Computed:            true,
+			},
+			"display_name_key": schema.StringAttribute{
+				MarkdownDescription: "The key of a field to reference as the display name for each object.",
+				Computed:            true,
+			},
+			"field_definitions": schema.ListNestedAttribute{
+				MarkdownDescription: "The fields defined for the metaobject definition.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The key of the field definition.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "A human-readable name for the field.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "An administrative description of the field.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The metafield type applied to values of the field.",
+							Computed:            true,
+						},
+						"required": schema.BoolAttribute{
+							MarkdownDescription: "Whether metaobjects require a saved value for the field.",
+							Computed:            true,
+						},
+						"validations": schema.ListNestedAttribute{
+							MarkdownDescription: "Custom validations that apply to values assigned to the field.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										MarkdownDescription: "The name for the metafield definition validation.",
+										Computed:            true,
+									},
+									"value": schema.StringAttribute{
+										MarkdownDescription: "The value for the metafield definition validation.",
+										Computed:            true,
+									},
+								},
+							},
+							Computed: true,
+						},
+					},
+				},
+				Computed: true,
+			},
+			"has_thumbnail_field": schema.BoolAttribute{
+				MarkdownDescription: "Whether this metaobject definition has field whose type can visually represent a metaobject with the thumbnailField.",
+				Computed:            true,
+			},
+			"access": schema.SingleNestedAttribute{
+				MarkdownDescription: "The access settings associated with the metaobject definition.",
+				Attributes: map[string]schema.Attribute{
+					"admin": schema.StringAttribute{
+						MarkdownDescription: "The default admin access setting used for the metafields under this definition.",
+						Computed:            true,
+					},
+					"storefront": schema.StringAttribute{
+						MarkdownDescription: "The storefront access setting used for the metafields under this definition.",
+						Computed:            true,
+					},
+				},
+				Computed: true,
+			},
+			"capabilities": schema.SingleNestedAttribute{
+				MarkdownDescription: "The capabilities of the metaobject definition.",
+				Attributes: map[string]schema.Attribute{
+					"publishable": schema.BoolAttribute{
+						MarkdownDescription: "Whether the metaobjects have a publication status of `ACTIVE` or `DRAFT`.",
+						Computed:            true,
+					},
+					"translatable": schema.BoolAttribute{
+						MarkdownDescription: "Whether the metaobjects can be translated.",
+						Computed:            true,
+					},
+					"renderable": schema.SingleNestedAttribute{
+						MarkdownDescription: "The renderable capability. Null if disabled.",
+						Attributes: map[string]schema.Attribute{
+							"meta_title_key": schema.StringAttribute{
+								MarkdownDescription: "The key of the field used as the meta title.",
+								Computed:            true,
+							},
+							"meta_description_key": schema.StringAttribute{
+								MarkdownDescription: "The key of the field used as the meta description.",
+								Computed:            true,
+							},
+						},
+						Computed: true,
+					},
+					"online_store": schema.SingleNestedAttribute{
+						MarkdownDescription: "The online store capability. Null if disabled.",
+						Attributes: map[string]schema.Attribute{
+							"url_handle": schema.StringAttribute{
+								MarkdownDescription: "The URL handle of the web pages.",
+								Computed:            true,
+							},
+							"create_redirects": schema.BoolAttribute{
+								MarkdownDescription: "Whether redirects are created automatically when the URL handle is changed.",
+								Computed:            true,
+							},
+						},
+						Computed: true,
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *MetaobjectDefinitionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (d *MetaobjectDefinitionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MetaobjectDefinitionDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	definition, err := d.client.GetMetaobjectDefinitionByType(ctx, data.Type.ValueString())
+	if errors.Is(err, shopify.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Metaobject Definition Not Found",
+			fmt.Sprintf("No metaobject definition found for type %q.", data.Type.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metaobject definition, got error: %s", err))
+		return
+	}
+
+	// The converter only stores the capabilities configured in the resource, while the data source returns all of them.
+	model, diags := convertMetaobjectDefinitionToResourceModel(ctx, definition, &MetaobjectDefinitionResourceModel{
+		Capabilities: &MetaobjectDefinitionCapabilitiesModel{},
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertMetaobjectDefinitionResourceModelToDataSourceModel(model))...)
+}
+
+func convertMetaobjectDefinitionResourceModelToDataSourceModel(model *MetaobjectDefinitionResourceModel) *MetaobjectDefinitionDataSourceModel {
+	fieldDefinitions := make([]*MetaobjectFieldDefinitionDataSourceModel, 0, len(model.FieldDefinitions))
+	for _, fieldDefinition := range model.FieldDefinitions {
+		fieldDefinitions = append(fieldDefinitions, &MetaobjectFieldDefinitionDataSourceModel{
+			Key:         fieldDefinition.Key,
+			Name:        fieldDefinition.Name,
+			Description: fieldDefinition.Description,
+			Type:        fieldDefinition.Type,
+			Required:    fieldDefinition.Required,
+			Validations: fieldDefinition.Validations,
+		})
+	}
+	return &MetaobjectDefinitionDataSourceModel{
+		ID:                model.ID,
+		Name:              model.Name,
+		Type:              model.Type,
+		Description:       model.Description,
+		DisplayNameKey:    model.DisplayNameKey,
+		FieldDefinitions:  fieldDefinitions,
+		HasThumbnailField: model.HasThumbnailField,
+		Access:            model.Access,
+		Capabilities:      model.Capabilities,
+	}
+}
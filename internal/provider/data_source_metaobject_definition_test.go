@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMetaobjectDefinitionDataSource(t *testing.T) {
+	metaobjectType := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAllowFake(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccMetaobjectDefinitionDataSourceConfig(metaobjectType),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.shopify_metaobject_definition.test", "id", "shopify_metaobject_definition.test", "id"),
+					resource.TestCheckResourceAttr("data.shopify_metaobject_definition.test", "name", "Test"),
+					resource.TestCheckResourceAttr("data.shopify_metaobject_definition.test", "display_name_key", "name"),
+					resource.TestCheckResourceAttr("data.shopify_metaobject_definition.test", "field_definitions.#", "2"),
+					resource.TestCheckResourceAttr("data.shopify_metaobject_definition.test", "field_definitions.0.key", "name"),
+					resource.TestCheckResourceAttr("data.shopify_metaobject_definition.test", "field_definitions.0.required", "true"),
+					resource.TestCheckResourceAttr("data.shopify_metaobject_definition.test", "field_definitions.1.key", "bio"),
+					resource.TestCheckResourceAttrPair("data.shopify_metaobject_definition.test", "access.admin", "shopify_metaobject_definition.test", "access.admin"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetaobjectDefinitionDataSourceConfig(metaobjectType string) string {
+	return fmt.Sprintf(`
+resource "shopify_metaobject_definition" "test" {
+  name             = "Test"
+  type             = %[1]q
+  display_name_key = "name"
+  field_definitions = [
+    {
+      key      = "name"
+      name     = "Name"
+      type     = "single_line_text_field"
+      required = true
+    },
+    {
+      key  = "bio"
+      name = "Bio"
+      type = "multi_line_text_field"
+    }
+  ]
+}
+
+data "shopify_metaobject_definition" "test" {
+  type = shopify_metaobject_definition.test.type
+}
+`, metaobjectType)
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+)
+
+// userErrorFieldRewriter rewrites the field of a user error before it's resolved into an attribute path,
+// e.g. to map the index of an operation list into the index of the corresponding list attribute.
+// Returning nil reports the user error without an attribute path.
+type userErrorFieldRewriter func(field []string) []string
+
+// appendClientError appends the error returned by the Shopify client to the diagnostics.
+// User errors whose field can be resolved into an attribute of the plan are reported as attribute errors,
+// so that Terraform points at the offending configuration. The other errors are reported as they were.
+func appendClientError(ctx context.Context, diags *diag.Diagnostics, plan tfsdk.Plan, action string, err error, rewriteField userErrorFieldRewriter) {
+	var unresolvedErrors []string
+	userErrors := shopify.AsUserErrors(err)
+	for _, userError := range userErrors {
+		field := userError.Field
+		if rewriteField != nil && len(field) > 0 {
+			field = rewriteField(field)
+		}
+		attrPath, ok := resolveUserErrorPath(ctx, plan, field, userError.ElementIndex)
+		if !ok {
+			unresolvedErrors = append(unresolvedErrors, userError.Error())
+			continue
+		}
+		diags.AddAttributeError(attrPath, "Client Error", fmt.Sprintf("Unable to %s, got error: %s", action, userError.Error()))
+	}
+	if len(userErrors) == 0 {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to %s, got error: %s", action, err))
+	} else if len(unresolvedErrors) > 0 {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to %s, got error: %s", action, strings.Join(unresolvedErrors, "\n")))
+	}
+}
+
+// resolveUserErrorPath resolves the field of a user error into the path of the attribute in the plan.
+// The field starts with the name of the mutation argument, e.g. ["definition", "fieldDefinitions", "4", "validations"],
+// which is resolved into `field_definitions[4].validations`.
+// Since some mutations take the attributes as arguments directly, the field is also tried as is.
+func resolveUserErrorPath(ctx context.Context, plan tfsdk.Plan, field []string, elementIndex *int) (path.Path, bool) {
+	if len(field) == 0 {
+		return path.Empty(), false
+	}
+	candidates := [][]string{field, field[1:]}
+	// The index of the list argument, e.g. ["metafields", "0", "value"] of metafieldsSet.
+	if len(field) > 2 && isListIndex(field[1]) {
+		candidates = append(candidates, field[2:])
+	}
+	for _, candidate := range candidates {
+		attrPath, hasIndex, ok := userErrorFieldToPath(candidate)
+		if !ok {
+			continue
+		}
+		attrPaths := []path.Path{attrPath}
+		// The element index points at the element of the list attribute if the field doesn't.
+		if elementIndex != nil && !hasIndex {
+			attrPaths = []path.Path{attrPath.AtListIndex(*elementIndex), attrPath}
+		}
+		for _, attrPath := range attrPaths {
+			if _, diags := plan.Schema.TypeAtPath(ctx, attrPath); !diags.HasError() && listIndexesInPlan(ctx, plan, attrPath) {
+				return attrPath, true
+			}
+		}
+	}
+	return path.Empty(), false
+}
+
+// listIndexesInPlan reports whether the list indexes of the path point at elements in the plan,
+// since the schema accepts any index.
+func listIndexesInPlan(ctx context.Context, plan tfsdk.Plan, attrPath path.Path) bool {
+	steps := attrPath.Steps()
+	for i, step := range steps {
+		index, ok := step.(path.PathStepElementKeyInt)
+		if !ok {
+			continue
+		}
+		listPath := attrPath
+		for j := i; j < len(steps); j++ {
+			listPath = listPath.ParentPath()
+		}
+		var list types.List
+		if diags := plan.GetAttribute(ctx, listPath, &list); diags.HasError() || list.IsNull() || list.IsUnknown() {
+			return false
+		}
+		if index < 0 || int(index) >= len(list.Elements()) {
+			return false
+		}
+	}
+	return true
+}
+
+func userErrorFieldToPath(field []string) (attrPath path.Path, hasIndex bool, ok bool) {
+	if len(field) == 0 || isListIndex(field[0]) {
+		return path.Empty(), false, false
+	}
+	attrPath = path.Root(toSnakeCase(field[0]))
+	for _, segment := range field[1:] {
+		if index, err := strconv.Atoi(segment); err == nil {
+			attrPath = attrPath.AtListIndex(index)
+			hasIndex = true
+		} else {
+			attrPath = attrPath.AtName(toSnakeCase(segment))
+		}
+	}
+	return attrPath, hasIndex, true
+}
+
+func isListIndex(segment string) bool {
+	_, err := strconv.Atoi(segment)
+	return err == nil
+}
+
+// toSnakeCase converts the name of a GraphQL input field into the name of the attribute, e.g. fieldDefinitions into field_definitions.
+func toSnakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
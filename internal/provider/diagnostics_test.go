@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+	"github.com/k-yomo/terraform-provider-shopify/internal/utils"
+)
+
+// testDiagnosticsPlan returns the plan of a metaobject definition having the "title" and "value" field definitions.
+func testDiagnosticsPlan(t *testing.T) tfsdk.Plan {
+	t.Helper()
+	r := &metaobjectDefinitionTestResource{resource: &MetaobjectDefinitionResource{}}
+	r.resource.Schema(context.Background(), resource.SchemaRequest{}, &r.schema)
+	return r.plan(t, testMetaobjectDefinitionModel("Test", "single_line_text_field", false))
+}
+
+func testUserError(code string, field ...string) shopify.UserError {
+	return shopify.UserError{Code: utils.Ptr(code), Field: field, Message: "test message"}
+}
+
+func TestToSnakeCase(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "", want: ""},
+		{s: "key", want: "key"},
+		{s: "fieldDefinitions", want: "field_definitions"},
+		{s: "displayNameKey", want: "display_name_key"},
+		{s: "Definition", want: "definition"},
+		{s: "already_snake", want: "already_snake"},
+	}
+	for _, tt := range tests {
+		if got := toSnakeCase(tt.s); got != tt.want {
+			t.Errorf("toSnakeCase(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestUserErrorFieldToPath(t *testing.T) {
+	tests := []struct {
+		name         string
+		field        []string
+		wantPath     path.Path
+		wantHasIndex bool
+		wantOK       bool
+	}{
+		{
+			name:  "empty",
+			field: nil,
+		},
+		{
+			name:  "starting with an index",
+			field: []string{"0", "value"},
+		},
+		{
+			name:     "camel case",
+			field:    []string{"displayNameKey"},
+			wantPath: path.Root("display_name_key"),
+			wantOK:   true,
+		},
+		{
+			name:         "numeric segments",
+			field:        []string{"fieldDefinitions", "2", "validations", "0", "name"},
+			wantPath:     path.Root("field_definitions").AtListIndex(2).AtName("validations").AtListIndex(0).AtName("name"),
+			wantHasIndex: true,
+			wantOK:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPath, gotHasIndex, gotOK := userErrorFieldToPath(tt.field)
+			if gotOK != tt.wantOK || gotHasIndex != tt.wantHasIndex {
+				t.Fatalf("userErrorFieldToPath() hasIndex = %v, ok = %v, want %v, %v", gotHasIndex, gotOK, tt.wantHasIndex, tt.wantOK)
+			}
+			if gotOK && !gotPath.Equal(tt.wantPath) {
+				t.Errorf("userErrorFieldToPath() path = %s, want %s", gotPath, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestResolveUserErrorPath(t *testing.T) {
+	plan := testDiagnosticsPlan(t)
+	tests := []struct {
+		name         string
+		field        []string
+		elementIndex *int
+		wantPath     path.Path
+		wantOK       bool
+	}{
+		{
+			name:     "field under the mutation argument",
+			field:    []string{"definition", "fieldDefinitions", "1", "key"},
+			wantPath: path.Root("field_definitions").AtListIndex(1).AtName("key"),
+			wantOK:   true,
+		},
+		{
+			name:     "field of the attribute as is",
+			field:    []string{"displayNameKey"},
+			wantPath: path.Root("display_name_key"),
+			wantOK:   true,
+		},
+		{
+			name:     "field under the index of the list argument",
+			field:    []string{"definitions", "0", "name"},
+			wantPath: path.Root("name"),
+			wantOK:   true,
+		},
+		{
+			name:         "element index",
+			field:        []string{"definition", "fieldDefinitions"},
+			elementIndex: utils.Ptr(1),
+			wantPath:     path.Root("field_definitions").AtListIndex(1),
+			wantOK:       true,
+		},
+		{
+			name:         "element index is ignored if the field has an index",
+			field:        []string{"definition", "fieldDefinitions", "0", "type"},
+			elementIndex: utils.Ptr(1),
+			wantPath:     path.Root("field_definitions").AtListIndex(0).AtName("type"),
+			wantOK:       true,
+		},
+		{
+			name:         "out-of-range element index falls back to the list",
+			field:        []string{"definition", "fieldDefinitions"},
+			elementIndex: utils.Ptr(5),
+			wantPath:     path.Root("field_definitions"),
+			wantOK:       true,
+		},
+		{
+			name:  "out-of-range index",
+			field: []string{"definition", "fieldDefinitions", "2", "key"},
+		},
+		{
+			name:  "index of a null list",
+			field: []string{"definition", "fieldDefinitions", "0", "validations", "0", "name"},
+		},
+		{
+			name:  "unknown field",
+			field: []string{"definition", "unknownField"},
+		},
+		{
+			name: "empty field",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPath, gotOK := resolveUserErrorPath(context.Background(), plan, tt.field, tt.elementIndex)
+			if gotOK != tt.wantOK {
+				t.Fatalf("resolveUserErrorPath() ok = %v, want %v (path %s)", gotOK, tt.wantOK, gotPath)
+			}
+			if gotOK && !gotPath.Equal(tt.wantPath) {
+				t.Errorf("resolveUserErrorPath() path = %s, want %s", gotPath, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestAppendClientError(t *testing.T) {
+	plan := testDiagnosticsPlan(t)
+	tests := []struct {
+		name         string
+		err          error
+		rewriteField userErrorFieldRewriter
+		// wantPaths are the paths of the error diagnostics in order, where an empty path is an error without a path.
+		wantPaths []path.Path
+	}{
+		{
+			name:      "not a user error",
+			err:       errors.New("connection reset"),
+			wantPaths: []path.Path{path.Empty()},
+		},
+		{
+			name: "resolved and unresolved user errors",
+			err: shopify.UserErrors{
+				testUserError("TAKEN", "definition", "fieldDefinitions", "0", "key"),
+				testUserError("INVALID", "definition", "unknownField"),
+				testUserError("INVALID"),
+			}.Error(),
+			wantPaths: []path.Path{path.Root("field_definitions").AtListIndex(0).AtName("key"), path.Empty()},
+		},
+		{
+			name:      "wrapped user error",
+			err:       fmt.Errorf("wrapped: %w", shopify.UserErrors{testUserError("BLANK", "definition", "name")}.Error()),
+			wantPaths: []path.Path{path.Root("name")},
+		},
+		{
+			name: "rewritten field",
+			err:  shopify.UserErrors{testUserError("INVALID", "definition", "fieldDefinitions", "9", "key")}.Error(),
+			rewriteField: func(field []string) []string {
+				return []string{"definition", "fieldDefinitions", "1", "key"}
+			},
+			wantPaths: []path.Path{path.Root("field_definitions").AtListIndex(1).AtName("key")},
+		},
+		{
+			name: "field rewritten into nil",
+			err:  shopify.UserErrors{testUserError("INVALID", "definition", "fieldDefinitions", "0", "delete")}.Error(),
+			rewriteField: func(field []string) []string {
+				return nil
+			},
+			wantPaths: []path.Path{path.Empty()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			appendClientError(context.Background(), &diags, plan, "update metaobject definition", tt.err, tt.rewriteField)
+
+			errs := diags.Errors()
+			if len(errs) != len(tt.wantPaths) {
+				t.Fatalf("got %d errors, want %d: %v", len(errs), len(tt.wantPaths), errs)
+			}
+			for i, err := range errs {
+				gotPath := path.Empty()
+				if withPath, ok := err.(diag.DiagnosticWithPath); ok {
+					gotPath = withPath.Path()
+				}
+				if !gotPath.Equal(tt.wantPaths[i]) {
+					t.Errorf("error %d path = %s, want %s: %v", i, gotPath, tt.wantPaths[i], err)
+				}
+			}
+		})
+	}
+}
+
+func TestFieldDefinitionOperationFieldRewriter(t *testing.T) {
+	operations := []*shopify.MetaobjectFieldDefinitionOperationInput{
+		{Update: &shopify.MetaobjectFieldDefinitionUpdateInput{Key: "title"}},
+		{Create: &shopify.MetaobjectFieldDefinitionCreateInput{Key: "value"}},
+		{Delete: &shopify.MetaobjectFieldDefinitionDeleteInput{Key: "old"}},
+		{Create: &shopify.MetaobjectFieldDefinitionCreateInput{Key: "not_in_plan"}},
+	}
+	fieldDefinitions := testMetaobjectDefinitionModel("Test", "single_line_text_field", false).FieldDefinitions
+	rewrite := fieldDefinitionOperationFieldRewriter(operations, fieldDefinitions)
+
+	tests := []struct {
+		name  string
+		field []string
+		want  []string
+	}{
+		{
+			name:  "update operation",
+			field: []string{"definition", "fieldDefinitions", "0", "update", "name"},
+			want:  []string{"definition", "fieldDefinitions", "0", "name"},
+		},
+		{
+			name:  "create operation",
+			field: []string{"definition", "fieldDefinitions", "1", "create", "key"},
+			want:  []string{"definition", "fieldDefinitions", "1", "key"},
+		},
+		{
+			name:  "operation without the operation name",
+			field: []string{"definition", "fieldDefinitions", "1"},
+			want:  []string{"definition", "fieldDefinitions", "1"},
+		},
+		{
+			name:  "delete operation",
+			field: []string{"definition", "fieldDefinitions", "2", "delete", "key"},
+		},
+		{
+			name:  "field definition not in the plan",
+			field: []string{"definition", "fieldDefinitions", "3", "create", "key"},
+		},
+		{
+			name:  "out-of-range index",
+			field: []string{"definition", "fieldDefinitions", "4", "create", "key"},
+		},
+		{
+			name:  "negative index",
+			field: []string{"definition", "fieldDefinitions", "-1", "create", "key"},
+		},
+		{
+			name:  "non-numeric index",
+			field: []string{"definition", "fieldDefinitions", "key"},
+		},
+		{
+			name:  "other field",
+			field: []string{"definition", "displayNameKey"},
+			want:  []string{"definition", "displayNameKey"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rewrite(tt.field); !slices.Equal(got, tt.want) || (got == nil) != (tt.want == nil) {
+				t.Errorf("rewrite(%v) = %v, want %v", tt.field, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &GIDBuildFunction{}
+
+func NewGIDBuildFunction() function.Function {
+	return &GIDBuildFunction{}
+}
+
+// GIDBuildFunction defines the function implementation.
+type GIDBuildFunction struct{}
+
+func (f *GIDBuildFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "gid_build"
+}
+
+func (f *GIDBuildFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Returns the Shopify GID of a resource",
+		MarkdownDescription: "Returns the Shopify GID of a resource, e.g. `gid://shopify/Page/123` for `Page` and `123`. The ID can also be given as a number.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "type",
+				MarkdownDescription: "The resource type, e.g. `Page`.",
+			},
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "The ID of the resource, e.g. `123`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *GIDBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceType, id string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &resourceType, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	gid, err := shopify.BuildGID(resourceType, id)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, gid))
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestGIDBuildFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck: func() { testFuncPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::shopify::gid_build("Page", "123")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("gid://shopify/Page/123")),
+				},
+			},
+			// The ID can also be given as a number.
+			{
+				Config: `
+output "test" {
+  value = provider::shopify::gid_build("Page", 123)
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("gid://shopify/Page/123")),
+				},
+			},
+			// gid_parse is the inverse of gid_build.
+			{
+				Config: `
+output "test" {
+  value = provider::shopify::gid_parse(provider::shopify::gid_build("Product", "456"))
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("456")),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::shopify::gid_build("gid://shopify/Page", "123")
+}
+`,
+				ExpectError: regexp.MustCompile(`is not a valid resource type`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::shopify::gid_build("Page", "")
+}
+`,
+				ExpectError: regexp.MustCompile(`is not a valid resource ID`),
+			},
+		},
+	})
+}
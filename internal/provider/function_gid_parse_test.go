@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestGIDParseFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck: func() { testFuncPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::shopify::gid_parse("gid://shopify/Page/123")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("123")),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::shopify::gid_parse("gid://shopify/Product/456?variant=1")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("456")),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::shopify::gid_parse("123")
+}
+`,
+				ExpectError: regexp.MustCompile(`is not a valid Shopify GID`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::shopify::gid_parse(null)
+}
+`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
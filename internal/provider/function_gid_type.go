@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &GIDTypeFunction{}
+
+func NewGIDTypeFunction() function.Function {
+	return &GIDTypeFunction{}
+}
+
+// GIDTypeFunction defines the function implementation.
+type GIDTypeFunction struct{}
+
+func (f *GIDTypeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "gid_type"
+}
+
+func (f *GIDTypeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Returns the resource type of a Shopify GID",
+		MarkdownDescription: "Returns the resource type of a Shopify GID, e.g. `Page` for `gid://shopify/Page/123`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "gid",
+				MarkdownDescription: "The GID, e.g. `gid://shopify/Page/123`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *GIDTypeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var gid string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &gid))
+	if resp.Error != nil {
+		return
+	}
+
+	resourceType, _, ok := shopify.ParseGID(gid)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a valid Shopify GID, e.g. gid://shopify/Page/123.", gid))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, resourceType))
+}
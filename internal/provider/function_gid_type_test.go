@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestGIDTypeFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck: func() { testFuncPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::shopify::gid_type("gid://shopify/MetaobjectDefinition/123")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("MetaobjectDefinition")),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::shopify::gid_type("gid://shopify/Page")
+}
+`,
+				ExpectError: regexp.MustCompile(`is not a valid Shopify GID`),
+			},
+		},
+	})
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+)
+
+var _ validator.String = metafieldTypeValidator{}
+
+// metafieldTypeValidator warns when the value is not a metafield type in the catalog of the shopify package.
+// It doesn't fail the plan, since Shopify may have added the type after the catalog was updated,
+// in which case the type is sent as is and validated by Shopify.
+type metafieldTypeValidator struct{}
+
+func (v metafieldTypeValidator) Description(ctx context.Context) string {
+	return "value should be a metafield type known to the provider"
+}
+
+func (v metafieldTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v metafieldTypeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	typeName := req.ConfigValue.ValueString()
+	if _, ok := shopify.LookupMetafieldType(typeName); ok {
+		return
+	}
+
+	detail := fmt.Sprintf("%q is not a metafield type known to the provider.", typeName)
+	if suggestion := suggestMetafieldType(typeName); suggestion != "" {
+		detail += fmt.Sprintf(" Did you mean %q?", suggestion)
+	}
+	detail += " Refer to https://shopify.dev/docs/apps/build/custom-data/metafields/list-of-data-types for the supported types. " +
+		"If the type has been added by Shopify recently, it's sent as is and the validations are left to Shopify."
+	resp.Diagnostics.AddAttributeWarning(req.Path, "Unknown Metafield Type", detail)
+}
+
+// suggestMetafieldType returns the supported type closest to the given type name, or empty if no type is close enough.
+func suggestMetafieldType(typeName string) string {
+	suggestion := ""
+	// Allow a missing suffix such as "_field" and a few typos.
+	bestDistance := 7
+	for _, name := range shopify.MetafieldTypeNames() {
+		distance := editDistance(typeName, name)
+		if distance < bestDistance {
+			suggestion, bestDistance = name, distance
+		}
+	}
+	return suggestion
+}
+
+// editDistance returns the Levenshtein distance between the strings.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+var _ validator.List = metafieldValidationsValidator{}
+
+// metafieldValidationsValidator validates that the names of the validations are accepted by
+// the metafield type set to the sibling `type` attribute.
+type metafieldValidationsValidator struct{}
+
+func (v metafieldValidationsValidator) Description(ctx context.Context) string {
+	return "validation names must be supported by the metafield type"
+}
+
+func (v metafieldValidationsValidator) MarkdownDescription(ctx context.Context) string {
+	return "validation names must be supported by the metafield `type`"
+}
+
+func (v metafieldValidationsValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var typeName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("type"), &typeName)...)
+	if resp.Diagnostics.HasError() || typeName.IsNull() || typeName.IsUnknown() {
+		return
+	}
+	// An unknown type is warned by metafieldTypeValidator, and its validations are left to Shopify.
+	metafieldType, ok := shopify.LookupMetafieldType(typeName.ValueString())
+	if !ok {
+		return
+	}
+
+	var validations []*MetafieldDefinitionValidationModel
+	resp.Diagnostics.Append(req.ConfigValue.ElementsAs(ctx, &validations, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i, validation := range validations {
+		if validation == nil || validation.Name.IsNull() || validation.Name.IsUnknown() {
+			continue
+		}
+		name := validation.Name.ValueString()
+		if metafieldType.SupportsValidation(name) {
+			continue
+		}
+		detail := fmt.Sprintf("%s type doesn't support %q validation.", metafieldType.Name, name)
+		if len(metafieldType.SupportedValidations) == 0 {
+			detail += " The type doesn't support any validation."
+		} else {
+			detail += fmt.Sprintf(" Supported validations: %s.", strings.Join(metafieldType.SupportedValidations, ", "))
+		}
+		resp.Diagnostics.AddAttributeError(req.Path.AtListIndex(i).AtName("name"), "Unsupported Metafield Validation", detail)
+	}
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a    string
+		b    string
+		want int
+	}{
+		{a: "", b: "", want: 0},
+		{a: "", b: "date", want: 4},
+		{a: "date", b: "date", want: 0},
+		{a: "date", b: "data", want: 1},
+		{a: "kitten", b: "sitting", want: 3},
+		{a: "single_line_text", b: "single_line_text_field", want: 6},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSuggestMetafieldType(t *testing.T) {
+	tests := []struct {
+		typeName string
+		want     string
+	}{
+		{typeName: "single_line_text", want: "single_line_text_field"},
+		{typeName: "number_integr", want: "number_integer"},
+		{typeName: "list.product_refrence", want: "list.product_reference"},
+		{typeName: "something_completely_different", want: ""},
+	}
+	for _, tt := range tests {
+		if got := suggestMetafieldType(tt.typeName); got != tt.want {
+			t.Errorf("suggestMetafieldType(%q) = %q, want %q", tt.typeName, got, tt.want)
+		}
+	}
+}
+
+func TestMetafieldTypeValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       types.String
+		wantWarning bool
+	}{
+		{name: "known type", value: types.StringValue("list.single_line_text_field")},
+		{name: "unknown type", value: types.StringValue("single_line_text"), wantWarning: true},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown value", value: types.StringUnknown()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("type"), ConfigValue: tt.value}
+			resp := validator.StringResponse{}
+			metafieldTypeValidator{}.ValidateString(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarning {
+				t.Fatalf("warning = %v, want %v: %v", got, tt.wantWarning, resp.Diagnostics)
+			}
+			if tt.wantWarning && resp.Diagnostics[0].Summary() != "Unknown Metafield Type" {
+				t.Errorf("summary = %q, want %q", resp.Diagnostics[0].Summary(), "Unknown Metafield Type")
+			}
+		})
+	}
+}
+
+func TestMetafieldValidationsValidator(t *testing.T) {
+	tests := []struct {
+		name          string
+		typeName      types.String
+		validations   []string
+		wantErrorPath []path.Path
+	}{
+		{
+			name:        "supported validations",
+			typeName:    types.StringValue("list.single_line_text_field"),
+			validations: []string{"max", "list.max"},
+		},
+		{
+			name:          "unsupported validations",
+			typeName:      types.StringValue("single_line_text_field"),
+			validations:   []string{"max", "list.max", "scale_min"},
+			wantErrorPath: []path.Path{path.Root("validations").AtListIndex(1).AtName("name"), path.Root("validations").AtListIndex(2).AtName("name")},
+		},
+		{
+			name:          "type without validations",
+			typeName:      types.StringValue("boolean"),
+			validations:   []string{"min"},
+			wantErrorPath: []path.Path{path.Root("validations").AtListIndex(0).AtName("name")},
+		},
+		{
+			name:        "unknown type",
+			typeName:    types.StringValue("new_type"),
+			validations: []string{"new_validation"},
+		},
+		{
+			name:        "unknown type value",
+			typeName:    types.StringUnknown(),
+			validations: []string{"new_validation"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			config, validations := testMetafieldValidationsConfig(t, tt.typeName, tt.validations)
+			req := validator.ListRequest{Path: path.Root("validations"), ConfigValue: validations, Config: config}
+			resp := validator.ListResponse{}
+			metafieldValidationsValidator{}.ValidateList(ctx, req, &resp)
+
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != len(tt.wantErrorPath) {
+				t.Fatalf("got %d errors, want %d: %v", len(errs), len(tt.wantErrorPath), errs)
+			}
+			for i, err := range errs {
+				withPath, ok := err.(diag.DiagnosticWithPath)
+				if !ok || !withPath.Path().Equal(tt.wantErrorPath[i]) {
+					t.Errorf("error %d is not at %s: %v", i, tt.wantErrorPath[i], err)
+				}
+				if err.Summary() != "Unsupported Metafield Validation" {
+					t.Errorf("summary = %q, want %q", err.Summary(), "Unsupported Metafield Validation")
+				}
+			}
+		})
+	}
+}
+
+func testMetafieldValidationsConfig(t *testing.T, typeName types.String, validationNames []string) (tfsdk.Config, types.List) {
+	t.Helper()
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{Required: true},
+			"validations": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name":  schema.StringAttribute{Required: true},
+						"value": schema.StringAttribute{Required: true},
+					},
+				},
+			},
+		},
+	}
+	validationType := types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType, "value": types.StringType}}
+	validationModels := make([]*MetafieldDefinitionValidationModel, 0, len(validationNames))
+	for _, name := range validationNames {
+		validationModels = append(validationModels, &MetafieldDefinitionValidationModel{Name: types.StringValue(name), Value: types.StringValue("1")})
+	}
+	validations, diags := types.ListValueFrom(ctx, validationType, validationModels)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	// tfsdk.Config is read-only, so the value is built as a plan.
+	plan := tfsdk.Plan{Schema: testSchema, Raw: tftypes.NewValue(testSchema.Type().TerraformType(ctx), nil)}
+	diags = plan.SetAttribute(ctx, path.Root("type"), typeName)
+	diags.Append(plan.SetAttribute(ctx, path.Root("validations"), validations)...)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	return tfsdk.Config{Schema: testSchema, Raw: plan.Raw}, validations
+}
@@ -5,12 +5,19 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
 	"net/http"
+	"net/url"
 	"os"
+	"time"
 
 	goshopify "github.com/bold-commerce/go-shopify/v4"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -29,6 +36,17 @@ type ShopifyProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+	// baseURL overrides the URL of the Shopify Admin API, e.g. to run the tests against a fake server.
+	baseURL string
+}
+
+type Option func(p *ShopifyProvider)
+
+// WithBaseURL sends the requests to the base URL instead of the shop's myshopify.com domain.
+func WithBaseURL(baseURL string) Option {
+	return func(p *ShopifyProvider) {
+		p.baseURL = baseURL
+	}
 }
 
 // ShopifyProviderModel describes the provider data model.
@@ -38,6 +56,14 @@ type ShopifyProviderModel struct {
 	APIKey              types.String `tfsdk:"api_key"`
 	APISecretKey        types.String `tfsdk:"api_secret_key"`
 	AdminAPIAccessToken types.String `tfsdk:"admin_api_access_token"`
+	MaxRetries          types.Int64  `tfsdk:"max_retries"`
+	MaxConcurrency      types.Int64  `tfsdk:"max_concurrency"`
+	BaseURL             types.String `tfsdk:"base_url"`
+	ProxyURL            types.String `tfsdk:"proxy_url"`
+	RequestTimeout      types.String `tfsdk:"request_timeout"`
+	CABundle            types.String `tfsdk:"ca_bundle"`
+	Headers             types.Map    `tfsdk:"headers"`
+	SensitiveLogFields  types.List   `tfsdk:"sensitive_log_fields"`
 }
 
 func (p *ShopifyProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -57,19 +83,54 @@ func (p *ShopifyProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Optional:            true,
 			},
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "Shopify app API key. Defaults to the env variable `SHOPIFY_API_KEY`.",
+				MarkdownDescription: "Shopify app API key, i.e. the client ID. Used with `api_secret_key` to obtain access tokens with the client credentials grant when `admin_api_access_token` is not set. Defaults to the env variable `SHOPIFY_API_KEY`.",
 				Optional:            true,
 			},
 			"api_secret_key": schema.StringAttribute{
-				MarkdownDescription: "Shopify app API secret key. Defaults to the env variable `SHOPIFY_API_SECRET_KEY`.",
+				MarkdownDescription: "Shopify app API secret key, i.e. the client secret. Defaults to the env variable `SHOPIFY_API_SECRET_KEY`.",
 				Optional:            true,
 				Sensitive:           true,
 			},
 			"admin_api_access_token": schema.StringAttribute{
-				MarkdownDescription: "Shopify Admin API access token.  Defaults to the env variable `SHOPIFY_ADMIN_API_ACCESS_TOKEN`.",
+				MarkdownDescription: "Shopify Admin API access token. If not set, access tokens are obtained and refreshed with the client credentials grant of the app specified by `api_key` and `api_secret_key`. Defaults to the env variable `SHOPIFY_ADMIN_API_ACCESS_TOKEN`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of retries of a GraphQL request which is throttled or fails with a server error. Mutations are not retried on server errors since they may have been applied. Defaults to `5`.",
+				Optional:            true,
+			},
+			"max_concurrency": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of GraphQL requests sent concurrently. Requests are also delayed until enough rate limit points are restored, so this is only needed to further limit the load. Defaults to `0`, which means unlimited.",
+				Optional:            true,
+			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "The base URL of the Admin API to send the requests to instead of `https://{shop}.myshopify.com`, e.g. the URL of a stub server. Defaults to the env variable `SHOPIFY_BASE_URL`.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the proxy to send the requests through, e.g. `http://proxy.example.com:3128`. Defaults to the proxy configured with the env variables `HTTPS_PROXY` and `NO_PROXY`.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "The time limit of each HTTP request to the Admin API, e.g. `30s`. Must be positive. Defaults to no limit.",
+				Optional:            true,
+			},
+			"ca_bundle": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CA certificates to trust in addition to the system's, e.g. `file(\"ca.pem\")` for a TLS-intercepting proxy.",
+				Optional:            true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Extra headers to set to every request.",
+				ElementType:         types.StringType,
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"sensitive_log_fields": schema.ListAttribute{
+				MarkdownDescription: "Names of the JSON fields and the form parameters to mask in the debug logs of the requests and the responses, in addition to the access tokens and the OAuth secrets. The values of `headers` are always masked.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -90,26 +151,46 @@ func (p *ShopifyProvider) Configure(ctx context.Context, req provider.ConfigureR
 		resp.Diagnostics.AddError("Unable to find api_version", "api_version cannot be an empty string")
 	}
 	apiKey := readOrEnvDefault(data.APIKey, "SHOPIFY_API_KEY")
-	if apiKey == "" {
-		resp.Diagnostics.AddError("Unable to find api_key", "api_key cannot be an empty string")
-	}
 	apiSecretKey := readOrEnvDefault(data.APISecretKey, "SHOPIFY_API_SECRET_KEY")
-	if apiSecretKey == "" {
-		resp.Diagnostics.AddError("Unable to find api_secret_key", "api_secret_key cannot be an empty string")
-	}
 	adminAPIAccessToken := readOrEnvDefault(data.AdminAPIAccessToken, "SHOPIFY_ADMIN_API_ACCESS_TOKEN")
+	// Without a static access token, tokens are obtained with the client credentials grant of the app.
 	if adminAPIAccessToken == "" {
-		resp.Diagnostics.AddError("Unable to find admin_api_access_token", "admin_api_access_token cannot be an empty string")
+		if apiKey == "" {
+			resp.Diagnostics.AddError("Unable to find api_key", "api_key cannot be an empty string when admin_api_access_token is not set")
+		}
+		if apiSecretKey == "" {
+			resp.Diagnostics.AddError("Unable to find api_secret_key", "api_secret_key cannot be an empty string when admin_api_access_token is not set")
+		}
+	}
+
+	if !data.MaxRetries.IsNull() && data.MaxRetries.ValueInt64() < 0 {
+		resp.Diagnostics.AddError("Invalid max_retries", "max_retries cannot be negative")
+	}
+	if !data.MaxConcurrency.IsNull() && data.MaxConcurrency.ValueInt64() < 0 {
+		resp.Diagnostics.AddError("Invalid max_concurrency", "max_concurrency cannot be negative")
 	}
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	opts := []goshopify.Option{goshopify.WithVersion(apiVersion)}
-	httpClient := http.DefaultClient
-	httpClient.Transport = utils.NewDebugTransport(http.DefaultTransport)
-	opts = append(opts, goshopify.WithHTTPClient(httpClient))
+	httpClient, diags := p.newHTTPClient(ctx, &data)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	if adminAPIAccessToken == "" {
+		tokenSource := shopify.NewClientCredentialsTokenSource(httpClient, shop, apiKey, apiSecretKey)
+		// The token is obtained here to report invalid credentials as a configuration error.
+		if _, err := tokenSource.Token(ctx); err != nil {
+			resp.Diagnostics.AddError("Unable to obtain Shopify access token", err.Error())
+			return
+		}
+		httpClient = &http.Client{
+			Transport: shopify.NewAccessTokenTransport(tokenSource, httpClient.Transport),
+			Timeout:   httpClient.Timeout,
+		}
+	}
+	opts := []goshopify.Option{goshopify.WithVersion(apiVersion), goshopify.WithHTTPClient(httpClient)}
 
 	app := goshopify.App{
 		ApiKey:    apiKey,
@@ -129,32 +210,126 @@ func (p *ShopifyProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	shopifyClient := shopify.NewClient(shopifyRawClient)
+	var clientOpts []shopify.Option
+	if !data.MaxRetries.IsNull() {
+		clientOpts = append(clientOpts, shopify.WithMaxRetries(int(data.MaxRetries.ValueInt64())))
+	}
+	if !data.MaxConcurrency.IsNull() {
+		clientOpts = append(clientOpts, shopify.WithMaxConcurrency(int(data.MaxConcurrency.ValueInt64())))
+	}
+	shopifyClient := shopify.NewClient(shopifyRawClient, clientOpts...)
 	resp.DataSourceData = shopifyClient
 	resp.ResourceData = shopifyClient
 }
 
+// newHTTPClient builds the HTTP client for the Admin API from the transport settings of the provider.
+func (p *ShopifyProvider) newHTTPClient(ctx context.Context, data *ShopifyProviderModel) (*http.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if proxyURL := data.ProxyURL.ValueString(); proxyURL != "" {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			diags.AddAttributeError(path.Root("proxy_url"), "Invalid proxy_url", err.Error())
+		} else {
+			transport.Proxy = http.ProxyURL(u)
+		}
+	}
+	if caBundle := data.CABundle.ValueString(); caBundle != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM([]byte(caBundle)) {
+			diags.AddAttributeError(path.Root("ca_bundle"), "Invalid ca_bundle", "ca_bundle must contain at least one PEM-encoded certificate")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+	}
+
+	headers := map[string]string{}
+	if !data.Headers.IsNull() {
+		diags.Append(data.Headers.ElementsAs(ctx, &headers, false)...)
+	}
+	var debugOpts []utils.DebugTransportOption
+	for name := range headers {
+		debugOpts = append(debugOpts, utils.WithSensitiveHeaders(name))
+	}
+	if !data.SensitiveLogFields.IsNull() {
+		var sensitiveLogFields []string
+		diags.Append(data.SensitiveLogFields.ElementsAs(ctx, &sensitiveLogFields, false)...)
+		debugOpts = append(debugOpts, utils.WithSensitiveFields(sensitiveLogFields...))
+	}
+
+	var roundTripper http.RoundTripper = utils.NewDebugTransport(transport, debugOpts...)
+	if len(headers) > 0 {
+		roundTripper = utils.NewHeaderTransport(headers, roundTripper)
+	}
+	baseURL := readOrEnvDefault(data.BaseURL, "SHOPIFY_BASE_URL")
+	if baseURL == "" {
+		baseURL = p.baseURL
+	}
+	if baseURL != "" {
+		u, err := url.Parse(baseURL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			diags.AddAttributeError(path.Root("base_url"), "Invalid base_url", fmt.Sprintf("base_url must be an absolute URL, got: %q", baseURL))
+		} else {
+			roundTripper = utils.NewBaseURLTransport(u, roundTripper)
+		}
+	}
+
+	httpClient := &http.Client{Transport: roundTripper}
+	if requestTimeout := data.RequestTimeout.ValueString(); requestTimeout != "" {
+		timeout, err := time.ParseDuration(requestTimeout)
+		if err != nil || timeout <= 0 {
+			diags.AddAttributeError(path.Root("request_timeout"), "Invalid request_timeout", fmt.Sprintf("request_timeout must be a positive duration such as 30s, got: %q", requestTimeout))
+		}
+		httpClient.Timeout = timeout
+	}
+	return httpClient, diags
+}
+
 func (p *ShopifyProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewArticleResource,
+		NewBlogResource,
+		NewCollectionResource,
+		NewMenuResource,
+		NewMetafieldResource,
 		NewMetafieldDefinitionResource,
+		NewMetaobjectResource,
 		NewMetaobjectDefinitionResource,
 		NewPageResource,
+		NewProductResource,
+		NewURLRedirectResource,
+		NewURLRedirectsResource,
+		NewWebhookSubscriptionResource,
 	}
 }
 
 func (p *ShopifyProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewMetafieldDefinitionDataSource,
+		NewMetaobjectDefinitionDataSource,
+	}
 }
 
 func (p *ShopifyProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewGIDBuildFunction,
+		NewGIDParseFunction,
+		NewGIDTypeFunction,
+	}
 }
 
-func New(version string) func() provider.Provider {
+func New(version string, opts ...Option) func() provider.Provider {
 	return func() provider.Provider {
-		return &ShopifyProvider{
+		p := &ShopifyProvider{
 			version: version,
 		}
+		for _, opt := range opts {
+			opt(p)
+		}
+		return p
 	}
 }
 
@@ -4,11 +4,21 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"net/url"
 	"os"
+	"os/exec"
 	"testing"
+	"time"
 
+	goshopify "github.com/bold-commerce/go-shopify/v4"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify/shopifytest"
+	"github.com/k-yomo/terraform-provider-shopify/internal/utils"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -19,7 +29,46 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"shopify": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// testAccFakeServer is the fake Shopify Admin API server the acceptance tests run against
+// when no store is configured with SHOPIFY_SHOP. Together with TF_ACC_TERRAFORM_PATH pointing to an installed
+// Terraform CLI, the acceptance tests run offline.
+var testAccFakeServer *shopifytest.Server
+
+func TestMain(m *testing.M) {
+	if os.Getenv("SHOPIFY_SHOP") == "" {
+		testAccFakeServer = shopifytest.NewServer()
+		for name, value := range map[string]string{
+			"SHOPIFY_SHOP":                   "fake",
+			"SHOPIFY_API_VERSION":            "2024-07",
+			"SHOPIFY_API_KEY":                "fake",
+			"SHOPIFY_API_SECRET_KEY":         "fake",
+			"SHOPIFY_ADMIN_API_ACCESS_TOKEN": "fake",
+		} {
+			os.Setenv(name, value)
+		}
+		testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+			"shopify": providerserver.NewProtocol6WithError(New("test", WithBaseURL(testAccFakeServer.URL))()),
+		}
+	}
+
+	code := m.Run()
+	if testAccFakeServer != nil {
+		testAccFakeServer.Close()
+	}
+	os.Exit(code)
+}
+
+// testAccPreCheck skips the test when running against the fake server,
+// since the fake only implements a part of the Admin API.
 func testAccPreCheck(t *testing.T) {
+	if testAccFakeServer != nil {
+		t.Skip("SHOPIFY_SHOP must be set to run the test against a real store")
+	}
+	testAccPreCheckAllowFake(t)
+}
+
+// testAccPreCheckAllowFake is the pre-check of the tests which also run against the fake server.
+func testAccPreCheckAllowFake(t *testing.T) {
 	mustEnv(t, "SHOPIFY_SHOP")
 	mustEnv(t, "SHOPIFY_API_VERSION")
 	mustEnv(t, "SHOPIFY_API_KEY")
@@ -27,9 +76,67 @@ func testAccPreCheck(t *testing.T) {
 	mustEnv(t, "SHOPIFY_ADMIN_API_ACCESS_TOKEN")
 }
 
+// testFuncPreCheck is the pre-check of the provider-defined function tests, which run without TF_ACC.
+// The tests are skipped unless the Terraform CLI is installed or specified, instead of downloading it.
+func testFuncPreCheck(t *testing.T) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" || os.Getenv("TF_ACC_TERRAFORM_VERSION") != "" {
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skip("Terraform CLI must be installed to run the provider-defined function tests")
+	}
+}
+
+// newFakeClient starts a new fake server and returns it with a client sending the requests to it,
+// for the unit tests calling the resources directly without the Terraform CLI.
+func newFakeClient(t *testing.T) (*shopifytest.Server, *shopify.Client) {
+	t.Helper()
+	server := shopifytest.NewServer()
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shopifyClient, err := goshopify.NewClient(goshopify.App{}, "fake", "fake", goshopify.WithVersion("2024-07"), goshopify.WithHTTPClient(&http.Client{
+		Transport: utils.NewBaseURLTransport(serverURL, http.DefaultTransport),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, shopify.NewClient(shopifyClient)
+}
+
 func mustEnv(t *testing.T, name string) {
 	t.Helper()
 	if os.Getenv(name) == "" {
 		t.Fatalf("%s environment variable must be set for acceptance tests", name)
 	}
 }
+
+func TestShopifyProvider_newHTTPClient_requestTimeout(t *testing.T) {
+	tests := []struct {
+		requestTimeout types.String
+		want           time.Duration
+		wantErr        bool
+	}{
+		{requestTimeout: types.StringNull(), want: 0},
+		{requestTimeout: types.StringValue("30s"), want: 30 * time.Second},
+		{requestTimeout: types.StringValue("1m30s"), want: 90 * time.Second},
+		{requestTimeout: types.StringValue("0"), wantErr: true},
+		{requestTimeout: types.StringValue("0s"), wantErr: true},
+		{requestTimeout: types.StringValue("-1s"), wantErr: true},
+		{requestTimeout: types.StringValue("30"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.requestTimeout.String(), func(t *testing.T) {
+			p := &ShopifyProvider{}
+			httpClient, diags := p.newHTTPClient(context.Background(), &ShopifyProviderModel{RequestTimeout: tt.requestTimeout})
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("newHTTPClient() diagnostics = %v, wantErr %v", diags, tt.wantErr)
+			}
+			if !tt.wantErr && httpClient.Timeout != tt.want {
+				t.Errorf("newHTTPClient() timeout = %s, want %s", httpClient.Timeout, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,353 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+	"github.com/k-yomo/terraform-provider-shopify/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ArticleResource{}
+var _ resource.ResourceWithImportState = &ArticleResource{}
+
+// ArticleResource defines the resource implementation.
+type ArticleResource struct {
+	client *shopify.Client
+}
+
+func NewArticleResource() resource.Resource {
+	return &ArticleResource{}
+}
+
+// ArticleResourceModel describes the resource data model.
+type ArticleResourceModel struct {
+	ID             types.String       `tfsdk:"id"`
+	BlogID         types.String       `tfsdk:"blog_id"`
+	Handle         types.String       `tfsdk:"handle"`
+	Author         types.String       `tfsdk:"author"`
+	Title          types.String       `tfsdk:"title"`
+	BodyHTML       types.String       `tfsdk:"body_html"`
+	SummaryHTML    types.String       `tfsdk:"summary_html"`
+	Tags           []types.String     `tfsdk:"tags"`
+	TemplateSuffix types.String       `tfsdk:"template_suffix"`
+	Published      types.Bool         `tfsdk:"published"`
+	PublishedAt    types.String       `tfsdk:"published_at"`
+	Image          *ArticleImageModel `tfsdk:"image"`
+}
+
+// ArticleImageModel describes the article image data model.
+type ArticleImageModel struct {
+	Src types.String `tfsdk:"src"`
+	Alt types.String `tfsdk:"alt"`
+}
+
+func (r *ArticleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_article"
+}
+
+func (r *ArticleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An article is a post in a blog of the online store.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique numeric identifier for the article.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"blog_id": schema.StringAttribute{
+				MarkdownDescription: "The numeric identifier of the blog containing the article.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"handle": schema.StringAttribute{
+				MarkdownDescription: "A unique, human-friendly string for the article. In themes, the Liquid templating language refers to an article by its handle.",
+				Required:            true,
+			},
+			"author": schema.StringAttribute{
+				MarkdownDescription: "The name of the author of the article.",
+				Required:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the article.",
+				Required:            true,
+			},
+			"body_html": schema.StringAttribute{
+				MarkdownDescription: "The text content of the article, complete with HTML markup.",
+				Required:            true,
+			},
+			"summary_html": schema.StringAttribute{
+				MarkdownDescription: "A summary of the article, complete with HTML markup. The summary is used by the online store theme to display the article on other pages, such as the home page or the main blog page.",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "A set of tags. Tags are additional short descriptors formatted as a string of comma-separated values.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"template_suffix": schema.StringAttribute{
+				MarkdownDescription: "The suffix of the template that is used to render the article. If the value is an empty string or null, then the default article template is used.",
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+				Computed:            true,
+			},
+			"published": schema.BoolAttribute{
+				MarkdownDescription: "Whether the article is published. If true, the article is visible to customers. If false, the article is hidden from customers.",
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+				Computed:            true,
+			},
+			"published_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time (ISO 8601 format) when the article was published.",
+				Computed:            true,
+			},
+			"image": schema.SingleNestedAttribute{
+				MarkdownDescription: "The image associated with the article. The image is copied to the Shopify CDN, so changes of the original image are not detected. Removing the image from the configuration removes it from the article.",
+				Attributes: map[string]schema.Attribute{
+					"src": schema.StringAttribute{
+						MarkdownDescription: "The source URL of the image.",
+						Required:            true,
+					},
+					"alt": schema.StringAttribute{
+						MarkdownDescription: "The alternative text of the image.",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *ArticleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *ArticleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ArticleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	blogID, err := strconv.ParseUint(data.BlogID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to parse blog ID", err.Error()))
+		return
+	}
+
+	createdArticle, err := r.client.Article().Create(ctx, blogID, convertArticleModelToArticle(&data))
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to create an article", err.Error()))
+		return
+	}
+
+	createdData := convertArticleToResourceModel(createdArticle, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *ArticleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ArticleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	blogID, err := strconv.ParseUint(data.BlogID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to parse blog ID", err.Error()))
+		return
+	}
+	id, err := strconv.ParseUint(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to parse ID", err.Error()))
+		return
+	}
+	article, err := r.client.Article().Get(ctx, blogID, id)
+	if errors.Is(err, shopify.ErrNotFound) {
+		tflog.Warn(ctx, "article not found, removing from state", map[string]interface{}{
+			"id": data.ID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to get article", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertArticleToResourceModel(article, &data))...)
+}
+
+func (r *ArticleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ArticleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	blogID, err := strconv.ParseUint(data.BlogID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to parse blog ID", err.Error()))
+		return
+	}
+	id, err := strconv.ParseUint(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to parse ID", err.Error()))
+		return
+	}
+
+	var stateImage *ArticleImageModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("image"), &stateImage)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	article := convertArticleModelToArticle(&data)
+	article.ID = id
+	// The image is only removed when requested explicitly.
+	article.RemoveImage = data.Image == nil && stateImage != nil
+	updatedArticle, err := r.client.Article().Update(ctx, blogID, article)
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to update article", err.Error()))
+		return
+	}
+
+	updatedData := convertArticleToResourceModel(updatedArticle, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, updatedData)...)
+}
+
+func (r *ArticleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ArticleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	blogID, err := strconv.ParseUint(data.BlogID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to parse blog ID", err.Error()))
+		return
+	}
+	id, err := strconv.ParseUint(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to parse ID", err.Error()))
+		return
+	}
+	if err := r.client.Article().Delete(ctx, blogID, id); err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to delete article", err.Error()))
+		return
+	}
+}
+
+// ImportState imports an article by the composite ID in the form of `blog_id/article_id`.
+func (r *ArticleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	blogID, articleID, ok := strings.Cut(req.ID, "/")
+	if !ok || blogID == "" || articleID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: blog_id/article_id. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("blog_id"), blogID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), articleID)...)
+}
+
+func convertArticleModelToArticle(data *ArticleResourceModel) shopify.Article {
+	tags := make([]string, 0, len(data.Tags))
+	for _, tag := range data.Tags {
+		tags = append(tags, tag.ValueString())
+	}
+	article := shopify.Article{
+		Handle:         data.Handle.ValueString(),
+		Author:         data.Author.ValueString(),
+		Title:          data.Title.ValueString(),
+		BodyHTML:       data.BodyHTML.ValueString(),
+		SummaryHTML:    utils.Ptr(data.SummaryHTML.ValueString()),
+		Tags:           strings.Join(tags, ", "),
+		TemplateSuffix: data.TemplateSuffix.ValueString(),
+		Published:      utils.Ptr(data.Published.ValueBool()),
+	}
+	if data.Image != nil {
+		article.Image = &shopify.ArticleImage{
+			Src: data.Image.Src.ValueString(),
+			Alt: data.Image.Alt.ValueString(),
+		}
+	}
+	return article
+}
+
+func convertArticleToResourceModel(article *shopify.Article, data *ArticleResourceModel) *ArticleResourceModel {
+	var publishedAt *string
+	if article.PublishedAt != nil {
+		publishedAtStr := article.PublishedAt.String()
+		publishedAt = &publishedAtStr
+	}
+
+	// Shopify API handles empty string and null as the same value
+	summaryHTML := types.StringPointerValue(article.SummaryHTML)
+	if (article.SummaryHTML == nil || *article.SummaryHTML == "") && data.SummaryHTML.IsNull() {
+		summaryHTML = types.StringNull()
+	}
+
+	var tags []types.String
+	if article.Tags != "" {
+		tagStrs := strings.Split(article.Tags, ",")
+		sort.Strings(tagStrs)
+		for _, tag := range tagStrs {
+			tags = append(tags, types.StringValue(strings.TrimSpace(tag)))
+		}
+	} else if data.Tags != nil {
+		tags = []types.String{}
+	}
+
+	// The image is copied to the Shopify CDN, so the source URL in the state is kept.
+	var image *ArticleImageModel
+	if article.Image != nil {
+		image = &ArticleImageModel{
+			Src: types.StringValue(article.Image.Src),
+			Alt: types.StringValue(article.Image.Alt),
+		}
+		if data.Image != nil {
+			image.Src = data.Image.Src
+		}
+		if article.Image.Alt == "" && (data.Image == nil || data.Image.Alt.IsNull()) {
+			image.Alt = types.StringNull()
+		}
+	}
+
+	return &ArticleResourceModel{
+		ID:             types.StringValue(strconv.FormatUint(article.ID, 10)),
+		BlogID:         types.StringValue(strconv.FormatUint(article.BlogID, 10)),
+		Handle:         types.StringValue(article.Handle),
+		Author:         types.StringValue(article.Author),
+		Title:          types.StringValue(article.Title),
+		BodyHTML:       types.StringValue(article.BodyHTML),
+		SummaryHTML:    summaryHTML,
+		Tags:           tags,
+		TemplateSuffix: types.StringValue(article.TemplateSuffix),
+		Published:      types.BoolValue(publishedAt != nil),
+		PublishedAt:    types.StringPointerValue(publishedAt),
+		Image:          image,
+	}
+}
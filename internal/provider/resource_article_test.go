@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccArticleResource(t *testing.T) {
+	handle := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccArticleResourceConfig(handle),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_article.test", "handle", handle),
+					resource.TestCheckResourceAttr("shopify_article.test", "author", "Author"),
+					resource.TestCheckResourceAttr("shopify_article.test", "title", "Test article"),
+					resource.TestCheckResourceAttr("shopify_article.test", "body_html", "<p>Test article</p>"),
+					resource.TestCheckResourceAttr("shopify_article.test", "published", "false"),
+					resource.TestCheckResourceAttrPair("shopify_article.test", "blog_id", "shopify_blog.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_article.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["shopify_article.test"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["blog_id"], rs.Primary.ID), nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccArticleResourceUpdateConfig(handle),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_article.test", "author", "Updated Author"),
+					resource.TestCheckResourceAttr("shopify_article.test", "title", "Updated test article"),
+					resource.TestCheckResourceAttr("shopify_article.test", "summary_html", "<p>Summary</p>"),
+					resource.TestCheckResourceAttr("shopify_article.test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("shopify_article.test", "published", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccArticleResourceConfig(handle string) string {
+	return fmt.Sprintf(`
+resource "shopify_blog" "test" {
+  handle = %[1]q
+  title  = "Test blog"
+}
+
+resource "shopify_article" "test" {
+  blog_id   = shopify_blog.test.id
+  handle    = %[1]q
+  author    = "Author"
+  title     = "Test article"
+  body_html = "<p>Test article</p>"
+}
+`, handle)
+}
+
+func testAccArticleResourceUpdateConfig(handle string) string {
+	return fmt.Sprintf(`
+resource "shopify_blog" "test" {
+  handle = %[1]q
+  title  = "Test blog"
+}
+
+resource "shopify_article" "test" {
+  blog_id      = shopify_blog.test.id
+  handle       = %[1]q
+  author       = "Updated Author"
+  title        = "Updated test article"
+  body_html    = "<p>Updated test article</p>"
+  summary_html = "<p>Summary</p>"
+  tags         = ["news", "terraform"]
+  published    = true
+}
+`, handle)
+}
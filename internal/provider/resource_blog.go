@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BlogResource{}
+var _ resource.ResourceWithImportState = &BlogResource{}
+
+// BlogResource defines the resource implementation.
+type BlogResource struct {
+	client *shopify.Client
+}
+
+func NewBlogResource() resource.Resource {
+	return &BlogResource{}
+}
+
+// BlogResourceModel describes the resource data model.
+type BlogResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Handle         types.String `tfsdk:"handle"`
+	Title          types.String `tfsdk:"title"`
+	Commentable    types.String `tfsdk:"commentable"`
+	TemplateSuffix types.String `tfsdk:"template_suffix"`
+}
+
+func (r *BlogResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blog"
+}
+
+func (r *BlogResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A blog is a collection of articles in the online store.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique numeric identifier for the blog.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"handle": schema.StringAttribute{
+				MarkdownDescription: "A unique, human-friendly string for the blog. In themes, the Liquid templating language refers to a blog by its handle.",
+				Required:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the blog.",
+				Required:            true,
+			},
+			"commentable": schema.StringAttribute{
+				MarkdownDescription: `Indicates whether readers can post comments to the blog and if comments are moderated or not.
+Possible values are:
+  - no
+  - moderate
+  - yes
+`,
+				Optional: true,
+				Default:  stringdefault.StaticString("no"),
+				Computed: true,
+			},
+			"template_suffix": schema.StringAttribute{
+				MarkdownDescription: "The suffix of the template that is used to render the blog. If the value is an empty string or null, then the default blog template is used.",
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *BlogResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *BlogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BlogResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	blog := goshopify.Blog{
+		Handle:         data.Handle.ValueString(),
+		Title:          data.Title.ValueString(),
+		Commentable:    data.Commentable.ValueString(),
+		TemplateSuffix: data.TemplateSuffix.ValueString(),
+	}
+	createdBlog, err := r.client.Blog().Create(ctx, blog)
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to create a blog", err.Error()))
+		return
+	}
+
+	createdData := convertBlogToResourceModel(createdBlog)
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *BlogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BlogResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseUint(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to parse ID", err.Error()))
+		return
+	}
+	blog, err := r.client.GetBlog(ctx, id)
+	if errors.Is(err, shopify.ErrNotFound) {
+		tflog.Warn(ctx, "blog not found, removing from state", map[string]interface{}{
+			"id": data.ID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to get blog", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertBlogToResourceModel(blog))...)
+}
+
+func (r *BlogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BlogResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id, err := strconv.ParseUint(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to parse ID", err.Error()))
+		return
+	}
+	blog := goshopify.Blog{
+		Id:             id,
+		Handle:         data.Handle.ValueString(),
+		Title:          data.Title.ValueString(),
+		Commentable:    data.Commentable.ValueString(),
+		TemplateSuffix: data.TemplateSuffix.ValueString(),
+	}
+	updatedBlog, err := r.client.Blog().Update(ctx, blog)
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to update blog", err.Error()))
+		return
+	}
+
+	updatedData := convertBlogToResourceModel(updatedBlog)
+	resp.Diagnostics.Append(resp.State.Set(ctx, updatedData)...)
+}
+
+func (r *BlogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BlogResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseUint(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to parse ID", err.Error()))
+		return
+	}
+	if err := r.client.Blog().Delete(ctx, id); err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to delete blog", err.Error()))
+		return
+	}
+}
+
+func (r *BlogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func convertBlogToResourceModel(blog *goshopify.Blog) *BlogResourceModel {
+	return &BlogResourceModel{
+		ID:             types.StringValue(strconv.FormatUint(blog.Id, 10)),
+		Handle:         types.StringValue(blog.Handle),
+		Title:          types.StringValue(blog.Title),
+		Commentable:    types.StringValue(blog.Commentable),
+		TemplateSuffix: types.StringValue(blog.TemplateSuffix),
+	}
+}
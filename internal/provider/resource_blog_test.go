@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBlogResource(t *testing.T) {
+	blogHandle := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccBlogResourceConfig(blogHandle, "Test blog", "no"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_blog.test", "handle", blogHandle),
+					resource.TestCheckResourceAttr("shopify_blog.test", "title", "Test blog"),
+					resource.TestCheckResourceAttr("shopify_blog.test", "commentable", "no"),
+					resource.TestCheckResourceAttr("shopify_blog.test", "template_suffix", ""),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_blog.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccBlogResourceConfig(blogHandle, "Updated test blog", "moderate"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_blog.test", "title", "Updated test blog"),
+					resource.TestCheckResourceAttr("shopify_blog.test", "commentable", "moderate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlogResourceConfig(blogHandle, title, commentable string) string {
+	return fmt.Sprintf(`
+resource "shopify_blog" "test" {
+  handle      = %[1]q
+  title       = %[2]q
+  commentable = %[3]q
+}
+`, blogHandle, title, commentable)
+}
@@ -0,0 +1,410 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CollectionResource{}
+var _ resource.ResourceWithImportState = &CollectionResource{}
+var _ resource.ResourceWithModifyPlan = &CollectionResource{}
+
+// CollectionResource defines the resource implementation.
+type CollectionResource struct {
+	client *shopify.Client
+}
+
+func NewCollectionResource() resource.Resource {
+	return &CollectionResource{}
+}
+
+// CollectionResourceModel describes the resource data model.
+type CollectionResourceModel struct {
+	ID              types.String            `tfsdk:"id"`
+	Handle          types.String            `tfsdk:"handle"`
+	Title           types.String            `tfsdk:"title"`
+	DescriptionHTML types.String            `tfsdk:"description_html"`
+	SortOrder       types.String            `tfsdk:"sort_order"`
+	TemplateSuffix  types.String            `tfsdk:"template_suffix"`
+	Image           *CollectionImageModel   `tfsdk:"image"`
+	SEO             *CollectionSEOModel     `tfsdk:"seo"`
+	RuleSet         *CollectionRuleSetModel `tfsdk:"rule_set"`
+}
+
+// CollectionImageModel describes the collection image data model.
+type CollectionImageModel struct {
+	Src types.String `tfsdk:"src"`
+	Alt types.String `tfsdk:"alt"`
+}
+
+// CollectionSEOModel describes the collection SEO data model.
+type CollectionSEOModel struct {
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+}
+
+// CollectionRuleSetModel describes the smart collection rule set data model.
+type CollectionRuleSetModel struct {
+	AppliedDisjunctively types.Bool             `tfsdk:"applied_disjunctively"`
+	Rules                []*CollectionRuleModel `tfsdk:"rules"`
+}
+
+// CollectionRuleModel describes the smart collection rule data model.
+type CollectionRuleModel struct {
+	Column            types.String `tfsdk:"column"`
+	Relation          types.String `tfsdk:"relation"`
+	Condition         types.String `tfsdk:"condition"`
+	ConditionObjectID types.String `tfsdk:"condition_object_id"`
+}
+
+func (r *CollectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection"
+}
+
+func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A collection is a group of products. A collection with `rule_set` is a smart collection whose products are selected automatically by the rules, otherwise it's a custom collection. Shopify can't convert a custom collection into a smart collection.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique ID of the collection.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"handle": schema.StringAttribute{
+				MarkdownDescription: "A unique, human-friendly string for the collection, generated automatically from its title if not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the collection.",
+				Required:            true,
+			},
+			"description_html": schema.StringAttribute{
+				MarkdownDescription: "The description of the collection, complete with HTML markup.",
+				Optional:            true,
+			},
+			"sort_order": schema.StringAttribute{
+				MarkdownDescription: "The order in which the products in the collection are displayed by default, e.g. `MANUAL`, `BEST_SELLING`, `ALPHA_ASC`, `ALPHA_DESC`, `PRICE_ASC`, `PRICE_DESC`, `CREATED` or `CREATED_DESC`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"template_suffix": schema.StringAttribute{
+				MarkdownDescription: "The suffix of the Liquid template being used to show the collection in the online store.",
+				Optional:            true,
+			},
+			"image": schema.SingleNestedAttribute{
+				MarkdownDescription: "The image associated with the collection. The image is copied to the Shopify CDN, so changes of the original image are not detected.",
+				Attributes: map[string]schema.Attribute{
+					"src": schema.StringAttribute{
+						MarkdownDescription: "The source URL of the image.",
+						Required:            true,
+					},
+					"alt": schema.StringAttribute{
+						MarkdownDescription: "The alternative text of the image.",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"seo": schema.SingleNestedAttribute{
+				MarkdownDescription: "The SEO information of the collection.",
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						MarkdownDescription: "The SEO title.",
+						Optional:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "The SEO description.",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"rule_set": schema.SingleNestedAttribute{
+				MarkdownDescription: "The rules used to assign products to the smart collection. Can't be added to an existing custom collection or removed from an existing smart collection.",
+				Attributes: map[string]schema.Attribute{
+					"applied_disjunctively": schema.BoolAttribute{
+						MarkdownDescription: "Whether products must match any (`true`) or all (`false`) of the rules to be included in the collection.",
+						Required:            true,
+					},
+					"rules": schema.ListNestedAttribute{
+						MarkdownDescription: "The rules used to assign products to the collection.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"column": schema.StringAttribute{
+									MarkdownDescription: "The attribute that the rule focuses on, e.g. `TITLE`, `TYPE`, `VENDOR`, `TAG`, `VARIANT_PRICE` or `PRODUCT_METAFIELD_DEFINITION`.",
+									Required:            true,
+								},
+								"relation": schema.StringAttribute{
+									MarkdownDescription: "The type of operator that the rule is based on, e.g. `EQUALS`, `NOT_EQUALS`, `CONTAINS`, `STARTS_WITH`, `GREATER_THAN` or `LESS_THAN`.",
+									Required:            true,
+								},
+								"condition": schema.StringAttribute{
+									MarkdownDescription: "The value that the operator is applied to.",
+									Required:            true,
+								},
+								"condition_object_id": schema.StringAttribute{
+									MarkdownDescription: "The ID of the object the condition refers to. For metafield conditions (e.g. `PRODUCT_METAFIELD_DEFINITION`), this is the ID of the metafield definition.",
+									Optional:            true,
+								},
+							},
+						},
+						Required: true,
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *CollectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+// ModifyPlan rejects the conversion between custom and smart collections, which Shopify doesn't support.
+func (r *CollectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip on resource creation or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state CollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	switch {
+	case state.RuleSet == nil && plan.RuleSet != nil:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rule_set"),
+			"Invalid Rule Set Change",
+			"A custom collection can't be converted into a smart collection. Recreate the collection to add rule_set.",
+		)
+	case state.RuleSet != nil && plan.RuleSet == nil:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rule_set"),
+			"Invalid Rule Set Change",
+			"A smart collection can't be converted into a custom collection. Recreate the collection to remove rule_set.",
+		)
+	}
+}
+
+func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdCollection, err := r.client.CreateCollection(ctx, convertCollectionModelToInput(&data))
+	if err != nil {
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "create collection", err, nil)
+		return
+	}
+
+	createdData := convertCollectionToResourceModel(createdCollection, &data)
+	tflog.Trace(ctx, "created a collection", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *CollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CollectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collection, err := r.client.GetCollection(ctx, data.ID.ValueString())
+	if errors.Is(err, shopify.ErrNotFound) {
+		tflog.Warn(ctx, "collection not found, removing from state", map[string]interface{}{
+			"id": data.ID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read collection, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertCollectionToResourceModel(collection, &data))...)
+}
+
+func (r *CollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := convertCollectionModelToInput(&data)
+	input.ID = data.ID.ValueStringPointer()
+	updatedCollection, err := r.client.UpdateCollection(ctx, input)
+	if err != nil {
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "update collection", err, nil)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertCollectionToResourceModel(updatedCollection, &data))...)
+}
+
+func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CollectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCollection(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete collection, got error: %s", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted a collection", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *CollectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func convertCollectionModelToInput(data *CollectionResourceModel) *shopify.CollectionInput {
+	input := &shopify.CollectionInput{
+		Title:           data.Title.ValueString(),
+		DescriptionHTML: data.DescriptionHTML.ValueString(),
+		TemplateSuffix:  data.TemplateSuffix.ValueString(),
+	}
+	if !data.Handle.IsUnknown() {
+		input.Handle = data.Handle.ValueStringPointer()
+	}
+	if !data.SortOrder.IsUnknown() {
+		input.SortOrder = data.SortOrder.ValueStringPointer()
+	}
+	if data.Image != nil {
+		input.Image = &shopify.ImageInput{
+			Src:     data.Image.Src.ValueString(),
+			AltText: data.Image.Alt.ValueStringPointer(),
+		}
+	}
+	if data.SEO != nil {
+		input.SEO = &shopify.SEOInput{
+			Title:       data.SEO.Title.ValueStringPointer(),
+			Description: data.SEO.Description.ValueStringPointer(),
+		}
+	} else {
+		input.SEO = &shopify.SEOInput{}
+	}
+	if data.RuleSet != nil {
+		rules := make([]*shopify.CollectionRuleInput, 0, len(data.RuleSet.Rules))
+		for _, rule := range data.RuleSet.Rules {
+			rules = append(rules, &shopify.CollectionRuleInput{
+				Column:            rule.Column.ValueString(),
+				Relation:          rule.Relation.ValueString(),
+				Condition:         rule.Condition.ValueString(),
+				ConditionObjectID: rule.ConditionObjectID.ValueStringPointer(),
+			})
+		}
+		input.RuleSet = &shopify.CollectionRuleSetInput{
+			AppliedDisjunctively: data.RuleSet.AppliedDisjunctively.ValueBool(),
+			Rules:                rules,
+		}
+	}
+	return input
+}
+
+func convertCollectionToResourceModel(collection *shopify.Collection, data *CollectionResourceModel) *CollectionResourceModel {
+	// Shopify API handles empty string and null as the same value
+	descriptionHTML := types.StringValue(collection.DescriptionHTML)
+	if collection.DescriptionHTML == "" && data.DescriptionHTML.IsNull() {
+		descriptionHTML = types.StringNull()
+	}
+	templateSuffix := types.StringPointerValue(collection.TemplateSuffix)
+	if (collection.TemplateSuffix == nil || *collection.TemplateSuffix == "") && data.TemplateSuffix.IsNull() {
+		templateSuffix = types.StringNull()
+	}
+
+	// The image is copied to the Shopify CDN, so the source URL in the state is kept.
+	var image *CollectionImageModel
+	if collection.Image != nil {
+		image = &CollectionImageModel{
+			Src: types.StringValue(collection.Image.URL),
+			Alt: types.StringPointerValue(collection.Image.AltText),
+		}
+		if data.Image != nil {
+			image.Src = data.Image.Src
+		}
+		if (collection.Image.AltText == nil || *collection.Image.AltText == "") && (data.Image == nil || data.Image.Alt.IsNull()) {
+			image.Alt = types.StringNull()
+		}
+	}
+
+	var seo *CollectionSEOModel
+	if collection.SEO != nil && (collection.SEO.Title != nil || collection.SEO.Description != nil || data.SEO != nil) {
+		seo = &CollectionSEOModel{
+			Title:       types.StringPointerValue(collection.SEO.Title),
+			Description: types.StringPointerValue(collection.SEO.Description),
+		}
+	}
+
+	var ruleSet *CollectionRuleSetModel
+	if collection.RuleSet != nil {
+		rules := make([]*CollectionRuleModel, 0, len(collection.RuleSet.Rules))
+		for _, rule := range collection.RuleSet.Rules {
+			ruleModel := &CollectionRuleModel{
+				Column:            types.StringValue(rule.Column),
+				Relation:          types.StringValue(rule.Relation),
+				Condition:         types.StringValue(rule.Condition),
+				ConditionObjectID: types.StringNull(),
+			}
+			if rule.ConditionObject != nil && rule.ConditionObject.MetafieldDefinition != nil {
+				ruleModel.ConditionObjectID = types.StringValue(rule.ConditionObject.MetafieldDefinition.ID)
+			}
+			rules = append(rules, ruleModel)
+		}
+		ruleSet = &CollectionRuleSetModel{
+			AppliedDisjunctively: types.BoolValue(collection.RuleSet.AppliedDisjunctively),
+			Rules:                rules,
+		}
+	}
+
+	return &CollectionResourceModel{
+		ID:              types.StringValue(collection.ID),
+		Handle:          types.StringValue(collection.Handle),
+		Title:           types.StringValue(collection.Title),
+		DescriptionHTML: descriptionHTML,
+		SortOrder:       types.StringValue(collection.SortOrder),
+		TemplateSuffix:  templateSuffix,
+		Image:           image,
+		SEO:             seo,
+		RuleSet:         ruleSet,
+	}
+}
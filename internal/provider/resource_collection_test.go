@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionResource(t *testing.T) {
+	handle := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCollectionResourceConfig(handle, "Test collection", "MANUAL"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_collection.test", "handle", handle),
+					resource.TestCheckResourceAttr("shopify_collection.test", "title", "Test collection"),
+					resource.TestCheckResourceAttr("shopify_collection.test", "sort_order", "MANUAL"),
+					resource.TestCheckResourceAttr("shopify_collection.test", "seo.title", "SEO title"),
+					resource.TestCheckNoResourceAttr("shopify_collection.test", "rule_set"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_collection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccCollectionResourceConfig(handle, "Updated test collection", "ALPHA_ASC"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_collection.test", "title", "Updated test collection"),
+					resource.TestCheckResourceAttr("shopify_collection.test", "sort_order", "ALPHA_ASC"),
+				),
+			},
+			// Converting a custom collection into a smart collection is not allowed
+			{
+				Config:      testAccCollectionResourceSmartConfig(handle, "Shirts"),
+				ExpectError: regexp.MustCompile("Invalid Rule Set Change"),
+			},
+		},
+	})
+}
+
+func TestAccCollectionResource_Smart(t *testing.T) {
+	handle := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCollectionResourceSmartConfig(handle, "Shirts"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_collection.test", "rule_set.applied_disjunctively", "false"),
+					resource.TestCheckResourceAttr("shopify_collection.test", "rule_set.rules.#", "1"),
+					resource.TestCheckResourceAttr("shopify_collection.test", "rule_set.rules.0.condition", "Shirts"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_collection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccCollectionResourceSmartConfig(handle, "Pants"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_collection.test", "rule_set.rules.0.condition", "Pants"),
+				),
+			},
+			// Converting a smart collection into a custom collection is not allowed
+			{
+				Config:      testAccCollectionResourceConfig(handle, "Test smart collection", "MANUAL"),
+				ExpectError: regexp.MustCompile("Invalid Rule Set Change"),
+			},
+		},
+	})
+}
+
+func testAccCollectionResourceConfig(handle, title, sortOrder string) string {
+	return fmt.Sprintf(`
+resource "shopify_collection" "test" {
+  handle     = %[1]q
+  title      = %[2]q
+  sort_order = %[3]q
+  seo = {
+    title = "SEO title"
+  }
+}
+`, handle, title, sortOrder)
+}
+
+func testAccCollectionResourceSmartConfig(handle, condition string) string {
+	return fmt.Sprintf(`
+resource "shopify_collection" "test" {
+  handle = %[1]q
+  title  = "Test smart collection"
+  rule_set = {
+    applied_disjunctively = false
+    rules = [
+      {
+        column    = "TYPE"
+        relation  = "EQUALS"
+        condition = %[2]q
+      },
+    ]
+  }
+}
+`, handle, condition)
+}
@@ -0,0 +1,377 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MenuResource{}
+var _ resource.ResourceWithImportState = &MenuResource{}
+
+// MenuResource defines the resource implementation.
+type MenuResource struct {
+	client *shopify.Client
+}
+
+func NewMenuResource() resource.Resource {
+	return &MenuResource{}
+}
+
+// MenuResourceModel describes the resource data model.
+type MenuResourceModel struct {
+	ID        types.String     `tfsdk:"id"`
+	Handle    types.String     `tfsdk:"handle"`
+	Title     types.String     `tfsdk:"title"`
+	IsDefault types.Bool       `tfsdk:"is_default"`
+	Items     []*MenuItemModel `tfsdk:"items"`
+}
+
+// MenuItemAttributesModel describes the attributes shared by the menu items of all levels.
+type MenuItemAttributesModel struct {
+	ID         types.String   `tfsdk:"id"`
+	Title      types.String   `tfsdk:"title"`
+	Type       types.String   `tfsdk:"type"`
+	ResourceID types.String   `tfsdk:"resource_id"`
+	URL        types.String   `tfsdk:"url"`
+	Tags       []types.String `tfsdk:"tags"`
+}
+
+// MenuItemModel describes the top level menu item data model.
+type MenuItemModel struct {
+	MenuItemAttributesModel
+	Items []*MenuChildItemModel `tfsdk:"items"`
+}
+
+// MenuChildItemModel describes the second level menu item data model.
+type MenuChildItemModel struct {
+	MenuItemAttributesModel
+	Items []*MenuItemAttributesModel `tfsdk:"items"`
+}
+
+func (r *MenuResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_menu"
+}
+
+func (r *MenuResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Shopify supports up to 3 levels of menu items.
+	grandchildItemAttributes := menuItemSchemaAttributes(nil)
+	childItemAttributes := menuItemSchemaAttributes(grandchildItemAttributes)
+	itemAttributes := menuItemSchemaAttributes(childItemAttributes)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A menu is the navigation of the online store, e.g. the header or footer menu. The default menus (`main-menu` and `footer`) can't be deleted, so they are only removed from the state on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique ID of the menu.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"handle": schema.StringAttribute{
+				MarkdownDescription: "A unique, human-friendly string for the menu. In themes, the Liquid templating language refers to a menu by its handle.",
+				Required:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the menu.",
+				Required:            true,
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "Whether the menu is a default menu of the shop. Default menus can't be deleted.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				MarkdownDescription: "The items of the menu. Items can be nested up to 3 levels.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: itemAttributes,
+				},
+				Required: true,
+			},
+		},
+	}
+}
+
+// menuItemSchemaAttributes returns the schema attributes of a menu item.
+// If childItemAttributes is given, the item has the nested `items` attribute.
+func menuItemSchemaAttributes(childItemAttributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the menu item. Items are matched with the existing ones by their positions, so that they are updated in place instead of being recreated.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"title": schema.StringAttribute{
+			MarkdownDescription: "The title of the menu item.",
+			Required:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "The type of the menu item, e.g. `PAGE`, `COLLECTION`, `PRODUCT`, `BLOG`, `ARTICLE`, `FRONTPAGE`, `SEARCH` or `HTTP`.",
+			Required:            true,
+		},
+		"resource_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the resource the menu item links to, e.g. `gid://shopify/Page/123`. Required for resource types such as `PAGE` and `COLLECTION`.",
+			Optional:            true,
+		},
+		"url": schema.StringAttribute{
+			MarkdownDescription: "The URL the menu item links to. Required for `HTTP` items. For resource items, the URL is derived from the resource.",
+			Optional:            true,
+		},
+		"tags": schema.ListAttribute{
+			MarkdownDescription: "The tags to filter the collection the menu item links to.",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+	}
+	if childItemAttributes != nil {
+		attributes["items"] = schema.ListNestedAttribute{
+			MarkdownDescription: "The nested items of the menu item.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: childItemAttributes,
+			},
+			Optional: true,
+		}
+	}
+	return attributes
+}
+
+func (r *MenuResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *MenuResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MenuResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdMenu, err := r.client.CreateMenu(ctx, data.Title.ValueString(), data.Handle.ValueString(), convertMenuItemModelsToInputs(data.Items))
+	if err != nil {
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "create menu", err, nil)
+		return
+	}
+
+	createdData := convertMenuToResourceModel(createdMenu, &data)
+	tflog.Trace(ctx, "created a menu", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *MenuResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MenuResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	menu, err := r.client.GetMenu(ctx, data.ID.ValueString())
+	if errors.Is(err, shopify.ErrNotFound) {
+		tflog.Warn(ctx, "menu not found, removing from state", map[string]interface{}{
+			"id": data.ID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read menu, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertMenuToResourceModel(menu, &data))...)
+}
+
+func (r *MenuResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MenuResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedMenu, err := r.client.UpdateMenu(ctx, data.ID.ValueString(), data.Title.ValueString(), data.Handle.ValueString(), convertMenuItemModelsToInputs(data.Items))
+	if err != nil {
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "update menu", err, nil)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertMenuToResourceModel(updatedMenu, &data))...)
+}
+
+func (r *MenuResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MenuResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.IsDefault.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Default menu is not deleted",
+			fmt.Sprintf("The menu %q is a default menu of the shop and can't be deleted, so it is only removed from the state.", data.Handle.ValueString()),
+		)
+		return
+	}
+
+	err := r.client.DeleteMenu(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete menu, got error: %s", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted a menu", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *MenuResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func convertMenuItemModelsToInputs(items []*MenuItemModel) []*shopify.MenuItemInput {
+	inputs := make([]*shopify.MenuItemInput, 0, len(items))
+	for _, item := range items {
+		childInputs := make([]*shopify.MenuItemInput, 0, len(item.Items))
+		for _, childItem := range item.Items {
+			grandchildInputs := make([]*shopify.MenuItemInput, 0, len(childItem.Items))
+			for _, grandchildItem := range childItem.Items {
+				grandchildInputs = append(grandchildInputs, convertMenuItemAttributesModelToInput(grandchildItem, nil))
+			}
+			childInputs = append(childInputs, convertMenuItemAttributesModelToInput(&childItem.MenuItemAttributesModel, grandchildInputs))
+		}
+		inputs = append(inputs, convertMenuItemAttributesModelToInput(&item.MenuItemAttributesModel, childInputs))
+	}
+	return inputs
+}
+
+func convertMenuItemAttributesModelToInput(item *MenuItemAttributesModel, childInputs []*shopify.MenuItemInput) *shopify.MenuItemInput {
+	tags := make([]string, 0, len(item.Tags))
+	for _, tag := range item.Tags {
+		tags = append(tags, tag.ValueString())
+	}
+	if childInputs == nil {
+		childInputs = []*shopify.MenuItemInput{}
+	}
+	// Items without ID are created, so the IDs carried from the state keep the existing items.
+	var id *string
+	if !item.ID.IsUnknown() && item.ID.ValueString() != "" {
+		id = item.ID.ValueStringPointer()
+	}
+	return &shopify.MenuItemInput{
+		ID:         id,
+		Title:      item.Title.ValueString(),
+		Type:       item.Type.ValueString(),
+		ResourceID: item.ResourceID.ValueStringPointer(),
+		URL:        item.URL.ValueStringPointer(),
+		Tags:       tags,
+		Items:      childInputs,
+	}
+}
+
+func convertMenuToResourceModel(menu *shopify.Menu, data *MenuResourceModel) *MenuResourceModel {
+	items := make([]*MenuItemModel, 0, len(menu.Items))
+	for i, item := range menu.Items {
+		var priorItem *MenuItemModel
+		if i < len(data.Items) {
+			priorItem = data.Items[i]
+		}
+
+		itemModel := &MenuItemModel{}
+		var priorChildItems []*MenuChildItemModel
+		if priorItem != nil {
+			itemModel.MenuItemAttributesModel = convertMenuItemToAttributesModel(item, &priorItem.MenuItemAttributesModel)
+			priorChildItems = priorItem.Items
+		} else {
+			itemModel.MenuItemAttributesModel = convertMenuItemToAttributesModel(item, nil)
+		}
+
+		for j, childItem := range item.Items {
+			var priorChildItem *MenuChildItemModel
+			if j < len(priorChildItems) {
+				priorChildItem = priorChildItems[j]
+			}
+
+			childItemModel := &MenuChildItemModel{}
+			var priorGrandchildItems []*MenuItemAttributesModel
+			if priorChildItem != nil {
+				childItemModel.MenuItemAttributesModel = convertMenuItemToAttributesModel(childItem, &priorChildItem.MenuItemAttributesModel)
+				priorGrandchildItems = priorChildItem.Items
+			} else {
+				childItemModel.MenuItemAttributesModel = convertMenuItemToAttributesModel(childItem, nil)
+			}
+
+			for k, grandchildItem := range childItem.Items {
+				var priorGrandchildItem *MenuItemAttributesModel
+				if k < len(priorGrandchildItems) {
+					priorGrandchildItem = priorGrandchildItems[k]
+				}
+				grandchildItemModel := convertMenuItemToAttributesModel(grandchildItem, priorGrandchildItem)
+				childItemModel.Items = append(childItemModel.Items, &grandchildItemModel)
+			}
+			if childItemModel.Items == nil && priorChildItem != nil && priorChildItem.Items != nil {
+				childItemModel.Items = []*MenuItemAttributesModel{}
+			}
+			itemModel.Items = append(itemModel.Items, childItemModel)
+		}
+		if itemModel.Items == nil && priorItem != nil && priorItem.Items != nil {
+			itemModel.Items = []*MenuChildItemModel{}
+		}
+		items = append(items, itemModel)
+	}
+
+	return &MenuResourceModel{
+		ID:        types.StringValue(menu.ID),
+		Handle:    types.StringValue(menu.Handle),
+		Title:     types.StringValue(menu.Title),
+		IsDefault: types.BoolValue(menu.IsDefault),
+		Items:     items,
+	}
+}
+
+// convertMenuItemToAttributesModel converts the menu item into the model.
+// prior is the corresponding item in the plan or state, which is nil if it doesn't exist.
+func convertMenuItemToAttributesModel(item *shopify.MenuItem, prior *MenuItemAttributesModel) MenuItemAttributesModel {
+	// Shopify derives the URL of non-HTTP items from the item type and the linked resource,
+	// so it's kept null unless it's configured.
+	url := types.StringPointerValue(item.URL)
+	if (prior != nil && prior.URL.IsNull()) || (prior == nil && item.Type != "HTTP") {
+		url = types.StringNull()
+	}
+
+	var tags []types.String
+	for _, tag := range item.Tags {
+		tags = append(tags, types.StringValue(tag))
+	}
+	if tags == nil && prior != nil && prior.Tags != nil {
+		tags = []types.String{}
+	}
+
+	return MenuItemAttributesModel{
+		ID:         types.StringValue(item.ID),
+		Title:      types.StringValue(item.Title),
+		Type:       types.StringValue(item.Type),
+		ResourceID: types.StringPointerValue(item.ResourceID),
+		URL:        url,
+		Tags:       tags,
+	}
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccMenuResource(t *testing.T) {
+	handle := randResourceID(64)
+	// The first item is updated in place, keeping its ID.
+	compareFirstItemID := statecheck.CompareValue(compare.ValuesSame())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMenuResourceConfig(handle),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_menu.test", "handle", handle),
+					resource.TestCheckResourceAttr("shopify_menu.test", "title", "Test menu"),
+					resource.TestCheckResourceAttr("shopify_menu.test", "is_default", "false"),
+					resource.TestCheckResourceAttr("shopify_menu.test", "items.#", "2"),
+					resource.TestCheckResourceAttr("shopify_menu.test", "items.0.type", "FRONTPAGE"),
+					resource.TestCheckResourceAttr("shopify_menu.test", "items.1.type", "PAGE"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					compareFirstItemID.AddStateValue("shopify_menu.test", tfjsonpath.New("items").AtSliceIndex(0).AtMapKey("id")),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:            "shopify_menu.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"items"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccMenuResourceUpdateConfig(handle),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_menu.test", "title", "Updated test menu"),
+					resource.TestCheckResourceAttr("shopify_menu.test", "items.#", "1"),
+					resource.TestCheckResourceAttr("shopify_menu.test", "items.0.url", "https://example.com"),
+					resource.TestCheckResourceAttr("shopify_menu.test", "items.0.items.#", "1"),
+					resource.TestCheckResourceAttr("shopify_menu.test", "items.0.items.0.items.0.title", "Grandchild"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					compareFirstItemID.AddStateValue("shopify_menu.test", tfjsonpath.New("items").AtSliceIndex(0).AtMapKey("id")),
+				},
+			},
+		},
+	})
+}
+
+func testAccMenuResourceConfig(handle string) string {
+	return fmt.Sprintf(`
+resource "shopify_page" "test" {
+  handle    = %[1]q
+  author    = "Author"
+  title     = "Test page"
+  body_html = "<p>Test page</p>"
+}
+
+resource "shopify_menu" "test" {
+  handle = %[1]q
+  title  = "Test menu"
+  items = [
+    {
+      title = "Home"
+      type  = "FRONTPAGE"
+    },
+    {
+      title       = "Page"
+      type        = "PAGE"
+      resource_id = "gid://shopify/Page/${shopify_page.test.id}"
+    },
+  ]
+}
+`, handle)
+}
+
+func testAccMenuResourceUpdateConfig(handle string) string {
+	return fmt.Sprintf(`
+resource "shopify_menu" "test" {
+  handle = %[1]q
+  title  = "Updated test menu"
+  items = [
+    {
+      title = "Parent"
+      type  = "HTTP"
+      url   = "https://example.com"
+      items = [
+        {
+          title = "Child"
+          type  = "HTTP"
+          url   = "https://example.com/child"
+          items = [
+            {
+              title = "Grandchild"
+              type  = "HTTP"
+              url   = "https://example.com/child/grandchild"
+            },
+          ]
+        },
+      ]
+    },
+  ]
+}
+`, handle)
+}
+
+func TestConvertMenuItemModelsToInputs(t *testing.T) {
+	items := []*MenuItemModel{
+		{
+			MenuItemAttributesModel: MenuItemAttributesModel{
+				ID:    types.StringValue("gid://shopify/MenuItem/1"),
+				Title: types.StringValue("Home"),
+				Type:  types.StringValue("FRONTPAGE"),
+			},
+			Items: []*MenuChildItemModel{
+				{
+					MenuItemAttributesModel: MenuItemAttributesModel{
+						ID:    types.StringValue("gid://shopify/MenuItem/2"),
+						Title: types.StringValue("Child"),
+						Type:  types.StringValue("FRONTPAGE"),
+					},
+					Items: []*MenuItemAttributesModel{
+						{
+							ID:    types.StringUnknown(),
+							Title: types.StringValue("New grandchild"),
+							Type:  types.StringValue("FRONTPAGE"),
+						},
+					},
+				},
+			},
+		},
+		{
+			MenuItemAttributesModel: MenuItemAttributesModel{
+				ID:    types.StringUnknown(),
+				Title: types.StringValue("New item"),
+				Type:  types.StringValue("HTTP"),
+				URL:   types.StringValue("https://example.com"),
+			},
+		},
+	}
+
+	inputs := convertMenuItemModelsToInputs(items)
+	if len(inputs) != 2 {
+		t.Fatalf("got %d inputs, want 2", len(inputs))
+	}
+	if got := inputs[0].ID; got == nil || *got != "gid://shopify/MenuItem/1" {
+		t.Errorf("inputs[0].ID = %v, want gid://shopify/MenuItem/1", got)
+	}
+	if got := inputs[0].Items[0].ID; got == nil || *got != "gid://shopify/MenuItem/2" {
+		t.Errorf("inputs[0].Items[0].ID = %v, want gid://shopify/MenuItem/2", got)
+	}
+	if got := inputs[0].Items[0].Items[0].ID; got != nil {
+		t.Errorf("inputs[0].Items[0].Items[0].ID = %v, want nil for a new item", *got)
+	}
+	if got := inputs[1].ID; got != nil {
+		t.Errorf("inputs[1].ID = %v, want nil for a new item", *got)
+	}
+}
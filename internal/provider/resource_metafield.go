@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+	"github.com/k-yomo/terraform-provider-shopify/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MetafieldResource{}
+var _ resource.ResourceWithImportState = &MetafieldResource{}
+var _ resource.ResourceWithModifyPlan = &MetafieldResource{}
+
+// MetafieldResource defines the resource implementation.
+type MetafieldResource struct {
+	client *shopify.Client
+}
+
+func NewMetafieldResource() resource.Resource {
+	return &MetafieldResource{}
+}
+
+// MetafieldResourceModel describes the resource data model.
+type MetafieldResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	OwnerID   types.String `tfsdk:"owner_id"`
+	Namespace types.String `tfsdk:"namespace"`
+	Key       types.String `tfsdk:"key"`
+	Type      types.String `tfsdk:"type"`
+	Value     types.String `tfsdk:"value"`
+}
+
+func (r *MetafieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metafield"
+}
+
+func (r *MetafieldResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Metafields are a flexible way to attach additional information to a Shopify resource, e.g. the shop, a page or a product.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique ID of the metafield.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: "The GID of the resource that owns the metafield, e.g. `gid://shopify/Shop/1`.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The container for a group of metafields that the metafield is associated with.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier for the metafield within its namespace.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of data that the metafield stores. Refer to the list of [supported types](https://shopify.dev/docs/apps/build/custom-data/metafields/list-of-data-types). Defaults to the type of the matching metafield definition, and must be set if no definition exists.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The data stored in the metafield, always serialized as a string. JSON values are compared ignoring whitespace and key order.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *MetafieldResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+// ModifyPlan resolves the type from the matching metafield definition and validates the value against it.
+func (r *MetafieldResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Do not validate on resource destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data MetafieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.OwnerID.IsUnknown() || data.Namespace.IsUnknown() || data.Key.IsUnknown() {
+		return
+	}
+
+	ownerType, ok := shopify.MetafieldOwnerTypeFromGID(data.OwnerID.ValueString())
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("owner_id"),
+			"Invalid owner ID",
+			fmt.Sprintf("%q is not a valid Shopify GID, e.g. gid://shopify/Product/1.", data.OwnerID.ValueString()),
+		)
+		return
+	}
+
+	definition, err := r.client.FindMetafieldDefinition(ctx, ownerType, data.Namespace.ValueString(), data.Key.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metafield definition, got error: %s", err))
+		return
+	}
+
+	var configType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &configType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metafieldType := &shopify.MetafieldDefinitionType{Name: data.Type.ValueString()}
+	if definition != nil {
+		if !configType.IsNull() && !configType.IsUnknown() && configType.ValueString() != definition.Type.Name {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("type"),
+				"Metafield type mismatch",
+				fmt.Sprintf("The metafield definition %s.%s for %s has type %q, but got %q.", definition.Namespace, definition.Key, ownerType, definition.Type.Name, configType.ValueString()),
+			)
+			return
+		}
+		metafieldType = definition.Type
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("type"), types.StringValue(definition.Type.Name))...)
+	}
+
+	// The type is unknown when it's not configured and no definition exists yet.
+	if metafieldType.Name == "" || data.Value.IsUnknown() {
+		return
+	}
+	// Keep the value of the state if the JSON only differs in whitespace or key order, not to plan an update.
+	// Terraform accepts the prior value as the planned value of a non-computed attribute.
+	if metafieldType.IsJSON() && !req.State.Raw.IsNull() {
+		var stateValue types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("value"), &stateValue)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !stateValue.IsNull() && utils.JSONEqual(stateValue.ValueString(), data.Value.ValueString()) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value"), stateValue)...)
+		}
+	}
+	if err := metafieldType.ValidateValue(data.Value.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("value"), "Invalid metafield value", err.Error())
+	}
+}
+
+func (r *MetafieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MetafieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdMetafield, err := r.client.SetMetafield(ctx, convertMetafieldModelToSetInput(&data))
+	if err != nil {
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "create metafield", err, nil)
+		return
+	}
+
+	createdData := convertMetafieldToResourceModel(createdMetafield, &data)
+	tflog.Trace(ctx, "created a metafield", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *MetafieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MetafieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metafield, err := r.client.GetMetafield(ctx, data.ID.ValueString())
+	if errors.Is(err, shopify.ErrNotFound) {
+		tflog.Warn(ctx, "metafield not found, removing from state", map[string]interface{}{
+			"id": data.ID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metafield, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertMetafieldToResourceModel(metafield, &data))...)
+}
+
+func (r *MetafieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MetafieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedMetafield, err := r.client.SetMetafield(ctx, convertMetafieldModelToSetInput(&data))
+	if err != nil {
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "update metafield", err, nil)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertMetafieldToResourceModel(updatedMetafield, &data))...)
+}
+
+func (r *MetafieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MetafieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMetafield(ctx, &shopify.MetafieldIdentifierInput{
+		OwnerID:   data.OwnerID.ValueString(),
+		Namespace: data.Namespace.ValueString(),
+		Key:       data.Key.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete metafield, got error: %s", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted a metafield", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *MetafieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func convertMetafieldModelToSetInput(data *MetafieldResourceModel) *shopify.MetafieldsSetInput {
+	input := &shopify.MetafieldsSetInput{
+		OwnerID:   data.OwnerID.ValueString(),
+		Namespace: data.Namespace.ValueString(),
+		Key:       data.Key.ValueString(),
+		Value:     data.Value.ValueString(),
+	}
+	// When the type is unknown, Shopify uses the type of the metafield definition.
+	if !data.Type.IsUnknown() {
+		input.Type = data.Type.ValueString()
+	}
+	return input
+}
+
+func convertMetafieldToResourceModel(metafield *shopify.Metafield, data *MetafieldResourceModel) *MetafieldResourceModel {
+	// Shopify normalizes JSON values, so keep the configured value if it has the same content
+	// not to produce unnecessary diffs.
+	value := types.StringValue(metafield.Value)
+	metafieldType := &shopify.MetafieldDefinitionType{Name: metafield.Type}
+	if metafieldType.IsJSON() && utils.JSONEqual(metafield.Value, data.Value.ValueString()) {
+		value = data.Value
+	}
+
+	ownerID := data.OwnerID
+	if metafield.Owner != nil {
+		ownerID = types.StringValue(metafield.Owner.ID)
+	}
+
+	return &MetafieldResourceModel{
+		ID:        types.StringValue(metafield.ID),
+		OwnerID:   ownerID,
+		Namespace: types.StringValue(metafield.Namespace),
+		Key:       types.StringValue(metafield.Key),
+		Type:      types.StringValue(metafield.Type),
+		Value:     value,
+	}
+}
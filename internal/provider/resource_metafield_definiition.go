@@ -2,15 +2,24 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
 )
@@ -30,15 +39,17 @@ func NewMetafieldDefinitionResource() resource.Resource {
 
 // MetafieldDefinitionResourceModel describes the resource data model.
 type MetafieldDefinitionResourceModel struct {
-	ID          types.String                          `tfsdk:"id"`
-	Name        types.String                          `tfsdk:"name"`
-	Description types.String                          `tfsdk:"description"`
-	OwnerType   types.String                          `tfsdk:"owner_type"`
-	Namespace   types.String                          `tfsdk:"namespace"`
-	Key         types.String                          `tfsdk:"key"`
-	Type        types.String                          `tfsdk:"type"`
-	Pin         types.Bool                            `tfsdk:"pin"`
-	Validations []*MetafieldDefinitionValidationModel `tfsdk:"validations"`
+	ID           types.String                          `tfsdk:"id"`
+	Name         types.String                          `tfsdk:"name"`
+	Description  types.String                          `tfsdk:"description"`
+	OwnerType    types.String                          `tfsdk:"owner_type"`
+	Namespace    types.String                          `tfsdk:"namespace"`
+	Key          types.String                          `tfsdk:"key"`
+	Type         types.String                          `tfsdk:"type"`
+	Pin          types.Bool                            `tfsdk:"pin"`
+	Validations  []*MetafieldDefinitionValidationModel `tfsdk:"validations"`
+	Access       types.Object                          `tfsdk:"access"`
+	Capabilities types.Object                          `tfsdk:"capabilities"`
 }
 
 type MetafieldDefinitionValidationModel struct {
@@ -46,6 +57,56 @@ type MetafieldDefinitionValidationModel struct {
 	Value types.String `tfsdk:"value"`
 }
 
+type MetafieldDefinitionAccessModel struct {
+	Admin           types.String `tfsdk:"admin"`
+	Storefront      types.String `tfsdk:"storefront"`
+	CustomerAccount types.String `tfsdk:"customer_account"`
+}
+
+func (m *MetafieldDefinitionAccessModel) toTerraformObject(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, map[string]attr.Type{
+		"admin":            types.StringType,
+		"storefront":       types.StringType,
+		"customer_account": types.StringType,
+	}, m)
+}
+
+// toShopifyModel returns the input of the access settings. The settings not configured are omitted
+// so that Shopify keeps the current ones, since the admin access can't be set in the namespaces not reserved by apps.
+func (m *MetafieldDefinitionAccessModel) toShopifyModel() *shopify.MetafieldAccess {
+	return &shopify.MetafieldAccess{
+		Admin:           m.Admin.ValueString(),
+		Storefront:      m.Storefront.ValueString(),
+		CustomerAccount: m.CustomerAccount.ValueString(),
+	}
+}
+
+type MetafieldDefinitionCapabilitiesModel struct {
+	AdminFilterable          types.Bool `tfsdk:"admin_filterable"`
+	SmartCollectionCondition types.Bool `tfsdk:"smart_collection_condition"`
+}
+
+func (m *MetafieldDefinitionCapabilitiesModel) toTerraformObject(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, map[string]attr.Type{
+		"admin_filterable":           types.BoolType,
+		"smart_collection_condition": types.BoolType,
+	}, m)
+}
+
+// toShopifyModel returns the input of the capabilities, omitting the ones not configured.
+func (m *MetafieldDefinitionCapabilitiesModel) toShopifyModel() *shopify.MetafieldCapabilities {
+	capability := func(v types.Bool) *shopify.MetafieldCapability {
+		if v.IsNull() || v.IsUnknown() {
+			return nil
+		}
+		return &shopify.MetafieldCapability{Enabled: v.ValueBool()}
+	}
+	return &shopify.MetafieldCapabilities{
+		AdminFilterable:          capability(m.AdminFilterable),
+		SmartCollectionCondition: capability(m.SmartCollectionCondition),
+	}
+}
+
 func (r *MetafieldDefinitionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_metafield_definition"
 }
@@ -116,6 +177,7 @@ Possible values are:
 				MarkdownDescription: `The type of data that each of the metafields that belong to the metafield definition will store. Refer to the list of [supported types](https://shopify.dev/docs/apps/build/custom-data/metafields/list-of-data-types).`,
 				Required:            true,
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{metafieldTypeValidator{}},
 			},
 			"pin": schema.BoolAttribute{
 				MarkdownDescription: "Whether to pin the metafield definition.",
@@ -137,7 +199,68 @@ Possible values are:
 						},
 					},
 				},
+				Optional:   true,
+				Validators: []validator.List{metafieldValidationsValidator{}},
+			},
+			"access": schema.SingleNestedAttribute{
+				MarkdownDescription: "The access settings of the metafields under this definition. Settings not set here are left as they are in Shopify.",
+				Attributes: map[string]schema.Attribute{
+					"admin": schema.StringAttribute{
+						MarkdownDescription: "The admin access setting, e.g. `MERCHANT_READ_WRITE`. Can only be set for the definitions in the namespaces reserved by apps.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"storefront": schema.StringAttribute{
+						MarkdownDescription: "The Storefront API access setting, either `PUBLIC_READ` or `NONE`.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"customer_account": schema.StringAttribute{
+						MarkdownDescription: "The Customer Account API access setting, either `READ`, `READ_WRITE` or `NONE`.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
 				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"capabilities": schema.SingleNestedAttribute{
+				MarkdownDescription: "The capabilities of the metafield definition. Capabilities not set here are left as they are in Shopify.",
+				Attributes: map[string]schema.Attribute{
+					"admin_filterable": schema.BoolAttribute{
+						MarkdownDescription: "Whether the metafields can be used to filter the resource list in the Shopify admin.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"smart_collection_condition": schema.BoolAttribute{
+						MarkdownDescription: "Whether the metafields can be used as conditions of smart collections.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
@@ -168,13 +291,22 @@ func (r *MetafieldDefinitionResource) Create(ctx context.Context, req resource.C
 		Pin:         data.Pin.ValueBool(),
 		Validations: convertValidationModelsToValidations(data.Validations),
 	}
+	// Only the configured settings are sent, since the plan has the values kept from the state.
+	input.Access, input.Capabilities = r.configuredAccessAndCapabilities(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	createdMetafieldDefinition, err := r.client.CreateMetafieldDefinition(ctx, &input)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create metafield definition, got error: %s", err))
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "create metafield definition", err, nil)
 		return
 	}
 
-	createdData := convertMetafieldDefinitionToResourceModel(createdMetafieldDefinition, data)
+	createdData, diags := convertMetafieldDefinitionToResourceModel(ctx, createdMetafieldDefinition, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Trace(ctx, "created a metafield definition", map[string]interface{}{
 		"id": createdData.ID,
 	})
@@ -190,12 +322,23 @@ func (r *MetafieldDefinitionResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	metafieldDefinition, err := r.client.GetMetafieldDefinition(ctx, data.ID.ValueString())
+	if errors.Is(err, shopify.ErrNotFound) {
+		tflog.Warn(ctx, "metafield definition not found, removing from state", map[string]interface{}{
+			"id": data.ID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metafield definition, got error: %s", err))
 		return
 	}
 
-	metafieldDefinitionModel := convertMetafieldDefinitionToResourceModel(metafieldDefinition, data)
+	metafieldDefinitionModel, diags := convertMetafieldDefinitionToResourceModel(ctx, metafieldDefinition, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, metafieldDefinitionModel)...)
 }
 
@@ -215,13 +358,21 @@ func (r *MetafieldDefinitionResource) Update(ctx context.Context, req resource.U
 		Pin:         data.Pin.ValueBool(),
 		Validations: convertValidationModelsToValidations(data.Validations),
 	}
+	input.Access, input.Capabilities = r.configuredAccessAndCapabilities(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	updatedMetafieldDefinition, err := r.client.UpdateMetafieldDefinition(ctx, &input)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update metafield definition, got error: %s", err))
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "update metafield definition", err, nil)
 		return
 	}
-	updateData := convertMetafieldDefinitionToResourceModel(updatedMetafieldDefinition, data)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &updateData)...)
+	updateData, diags := convertMetafieldDefinitionToResourceModel(ctx, updatedMetafieldDefinition, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, updateData)...)
 }
 
 func (r *MetafieldDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -241,25 +392,110 @@ func (r *MetafieldDefinitionResource) Delete(ctx context.Context, req resource.D
 	})
 }
 
+// ImportState imports the metafield definition by either its global ID or `OWNER_TYPE/namespace/key`.
 func (r *MetafieldDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if strings.HasPrefix(req.ID, "gid://") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: gid://shopify/MetafieldDefinition/{id} or OWNER_TYPE/namespace/key. Got: %q", req.ID),
+		)
+		return
+	}
+	ownerType, namespace, key := parts[0], parts[1], parts[2]
+	definition, err := r.client.FindMetafieldDefinition(ctx, ownerType, namespace, key)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metafield definition, got error: %s", err))
+		return
+	}
+	if definition == nil {
+		resp.Diagnostics.AddError(
+			"Metafield Definition Not Found",
+			fmt.Sprintf("No metafield definition found for owner type %q, namespace %q and key %q.", ownerType, namespace, key),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), definition.ID)...)
+}
+
+// configuredAccessAndCapabilities returns the inputs of the access settings and the capabilities set in the config.
+func (r *MetafieldDefinitionResource) configuredAccessAndCapabilities(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) (*shopify.MetafieldAccess, *shopify.MetafieldCapabilities) {
+	var accessObject, capabilitiesObject types.Object
+	diags.Append(config.GetAttribute(ctx, path.Root("access"), &accessObject)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("capabilities"), &capabilitiesObject)...)
+	if diags.HasError() {
+		return nil, nil
+	}
+
+	var access *shopify.MetafieldAccess
+	if !accessObject.IsNull() && !accessObject.IsUnknown() {
+		var accessModel MetafieldDefinitionAccessModel
+		diags.Append(accessObject.As(ctx, &accessModel, basetypes.ObjectAsOptions{})...)
+		access = accessModel.toShopifyModel()
+	}
+	var capabilities *shopify.MetafieldCapabilities
+	if !capabilitiesObject.IsNull() && !capabilitiesObject.IsUnknown() {
+		var capabilitiesModel MetafieldDefinitionCapabilitiesModel
+		diags.Append(capabilitiesObject.As(ctx, &capabilitiesModel, basetypes.ObjectAsOptions{})...)
+		capabilities = capabilitiesModel.toShopifyModel()
+	}
+	return access, capabilities
 }
 
-func convertMetafieldDefinitionToResourceModel(definition *shopify.MetafieldDefinition, state MetafieldDefinitionResourceModel) *MetafieldDefinitionResourceModel {
+func convertMetafieldDefinitionToResourceModel(ctx context.Context, definition *shopify.MetafieldDefinition, state MetafieldDefinitionResourceModel) (*MetafieldDefinitionResourceModel, diag.Diagnostics) {
 	description := types.StringValue(definition.Description)
 	if len(definition.Description) == 0 && state.Description.IsNull() {
 		description = types.StringNull()
 	}
+	access, diags := convertMetafieldAccessToModel(definition.Access).toTerraformObject(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	capabilities, diags := convertMetafieldCapabilitiesToModel(definition.Capabilities).toTerraformObject(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
 	return &MetafieldDefinitionResourceModel{
-		ID:          types.StringValue(definition.ID),
-		Name:        types.StringValue(definition.Name),
-		Description: description,
-		OwnerType:   types.StringValue(definition.OwnerType),
-		Namespace:   types.StringValue(definition.Namespace),
-		Key:         types.StringValue(definition.Key),
-		Type:        types.StringValue(definition.Type.Name),
-		Pin:         types.BoolValue(definition.PinnedPosition != nil),
-		Validations: convertValidationsToModels(definition.Validations),
+		ID:           types.StringValue(definition.ID),
+		Name:         types.StringValue(definition.Name),
+		Description:  description,
+		OwnerType:    types.StringValue(definition.OwnerType),
+		Namespace:    types.StringValue(definition.Namespace),
+		Key:          types.StringValue(definition.Key),
+		Type:         types.StringValue(definition.Type.Name),
+		Pin:          types.BoolValue(definition.PinnedPosition != nil),
+		Validations:  convertValidationsToModels(definition.Validations),
+		Access:       access,
+		Capabilities: capabilities,
+	}, nil
+}
+
+func convertMetafieldAccessToModel(access *shopify.MetafieldAccess) *MetafieldDefinitionAccessModel {
+	if access == nil {
+		access = &shopify.MetafieldAccess{}
+	}
+	return &MetafieldDefinitionAccessModel{
+		Admin:           types.StringValue(access.Admin),
+		Storefront:      types.StringValue(access.Storefront),
+		CustomerAccount: types.StringValue(access.CustomerAccount),
+	}
+}
+
+func convertMetafieldCapabilitiesToModel(capabilities *shopify.MetafieldCapabilities) *MetafieldDefinitionCapabilitiesModel {
+	enabled := func(capability *shopify.MetafieldCapability) types.Bool {
+		return types.BoolValue(capability != nil && capability.Enabled)
+	}
+	if capabilities == nil {
+		capabilities = &shopify.MetafieldCapabilities{}
+	}
+	return &MetafieldDefinitionCapabilitiesModel{
+		AdminFilterable:          enabled(capabilities.AdminFilterable),
+		SmartCollectionCondition: enabled(capabilities.SmartCollectionCondition),
 	}
 }
 
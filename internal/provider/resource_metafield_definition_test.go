@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -10,7 +11,7 @@ import (
 func TestAccMetafieldDefinitionResource(t *testing.T) {
 	metafieldKey := randResourceID(64)
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { testAccPreCheckAllowFake(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// Create and Read testing
@@ -31,6 +32,13 @@ func TestAccMetafieldDefinitionResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState testing by owner type, namespace and key
+			{
+				ResourceName:      "shopify_metafield_definition.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("CUSTOMER/testacc/%s", metafieldKey),
+			},
 			// Update and Read testing
 			{
 				Config: testAccMetafieldDefinitionResourceUpdateConfig(metafieldKey),
@@ -40,12 +48,32 @@ func TestAccMetafieldDefinitionResource(t *testing.T) {
 					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "pin", "true"),
 					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "validations.0.name", "min"),
 					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "validations.0.value", "10"),
+					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "access.storefront", "PUBLIC_READ"),
+					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "capabilities.admin_filterable", "true"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccMetafieldDefinitionResource_invalidType(t *testing.T) {
+	metafieldKey := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAllowFake(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMetafieldDefinitionResourceTypeConfig(metafieldKey, "single_line_text", "max"),
+				ExpectError: regexp.MustCompile(`Did you mean "single_line_text_field"\?`),
+			},
+			{
+				Config:      testAccMetafieldDefinitionResourceTypeConfig(metafieldKey, "boolean", "max"),
+				ExpectError: regexp.MustCompile(`boolean type doesn't support "max" validation`),
+			},
+		},
+	})
+}
+
 func testAccMetafieldDefinitionResourceConfig(metafieldKey string) string {
 	return fmt.Sprintf(`
 resource "shopify_metafield_definition" "test" {
@@ -74,6 +102,30 @@ resource "shopify_metafield_definition" "test" {
 	  value = "10"	
     }
   ] 
+  access = {
+    storefront = "PUBLIC_READ"
+  }
+  capabilities = {
+    admin_filterable = true
+  }
 }
 `, metafieldKey)
 }
+
+func testAccMetafieldDefinitionResourceTypeConfig(metafieldKey string, metafieldType string, validationName string) string {
+	return fmt.Sprintf(`
+resource "shopify_metafield_definition" "test" {
+  key        = %[1]q
+  name       = "Terraform Test"
+  namespace  = "testacc"
+  owner_type = "PRODUCT"
+  type       = %[2]q
+  validations = [
+    {
+      name  = %[3]q
+      value = "10"
+    },
+  ]
+}
+`, metafieldKey, metafieldType, validationName)
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/k-yomo/terraform-provider-shopify/internal/utils"
+)
+
+func TestMetafieldResource_ModifyPlan_value(t *testing.T) {
+	tests := []struct {
+		name          string
+		metafieldType string
+		stateValue    *string
+		planValue     string
+		want          string
+	}{
+		{
+			name:          "reformatted JSON keeps the state value",
+			metafieldType: "json",
+			stateValue:    utils.Ptr(`{"a":1,"b":[1,2]}`),
+			planValue:     "{\n  \"b\": [1, 2],\n  \"a\": 1\n}",
+			want:          `{"a":1,"b":[1,2]}`,
+		},
+		{
+			name:          "changed JSON is planned",
+			metafieldType: "list.single_line_text_field",
+			stateValue:    utils.Ptr(`["a","b"]`),
+			planValue:     `["a", "c"]`,
+			want:          `["a", "c"]`,
+		},
+		{
+			name:          "non-JSON type is planned as configured",
+			metafieldType: "single_line_text_field",
+			stateValue:    utils.Ptr(`{"a":1}`),
+			planValue:     `{"a": 1}`,
+			want:          `{"a": 1}`,
+		},
+		{
+			name:          "create is planned as configured",
+			metafieldType: "json",
+			planValue:     `{"a": 1}`,
+			want:          `{"a": 1}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			_, client := newFakeClient(t)
+			r := &MetafieldResource{client: client}
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			newState := func(value string) tfsdk.State {
+				state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+				diags := state.Set(ctx, &MetafieldResourceModel{
+					ID:        types.StringValue("gid://shopify/Metafield/1"),
+					OwnerID:   types.StringValue("gid://shopify/Shop/1"),
+					Namespace: types.StringValue("custom"),
+					Key:       types.StringValue("settings"),
+					Type:      types.StringValue(tt.metafieldType),
+					Value:     types.StringValue(value),
+				})
+				if diags.HasError() {
+					t.Fatal(diags)
+				}
+				return state
+			}
+
+			planned := newState(tt.planValue)
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			if tt.stateValue != nil {
+				state = newState(*tt.stateValue)
+			}
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: planned.Raw}
+			config := tfsdk.Config{Schema: schemaResp.Schema, Raw: planned.Raw}
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state, Config: config}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() diagnostics: %v", resp.Diagnostics)
+			}
+
+			var got types.String
+			if diags := resp.Plan.GetAttribute(ctx, path.Root("value"), &got); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if got.ValueString() != tt.want {
+				t.Errorf("planned value = %q, want %q", got.ValueString(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMetafieldResource(t *testing.T) {
+	metafieldKey := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMetafieldResourceConfig(metafieldKey, `jsonencode({ enabled = true })`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metafield.test", "namespace", "testacc"),
+					resource.TestCheckResourceAttr("shopify_metafield.test", "key", metafieldKey),
+					resource.TestCheckResourceAttr("shopify_metafield.test", "type", "json"),
+					resource.TestCheckResourceAttr("shopify_metafield.test", "value", `{"enabled":true}`),
+					resource.TestCheckResourceAttrPair("shopify_metafield.test", "owner_id", "shopify_product.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_metafield.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccMetafieldResourceConfig(metafieldKey, `"{ \"enabled\": false }"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metafield.test", "value", `{ "enabled": false }`),
+				),
+			},
+			// Reformatting the JSON value doesn't plan an update
+			{
+				Config:   testAccMetafieldResourceConfig(metafieldKey, `"{\"enabled\":false}"`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccMetafieldResourceConfig(metafieldKey string, value string) string {
+	return fmt.Sprintf(`
+resource "shopify_product" "test" {
+  title  = "Metafield test product"
+  status = "DRAFT"
+}
+
+resource "shopify_metafield" "test" {
+  owner_id  = shopify_product.test.id
+  namespace = "testacc"
+  key       = %[1]q
+  type      = "json"
+  value     = %[2]s
+}
+`, metafieldKey, value)
+}
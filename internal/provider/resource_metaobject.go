@@ -0,0 +1,309 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+	"github.com/k-yomo/terraform-provider-shopify/pkg/xslice"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MetaobjectResource{}
+var _ resource.ResourceWithImportState = &MetaobjectResource{}
+var _ resource.ResourceWithModifyPlan = &MetaobjectResource{}
+
+// MetaobjectResource defines the resource implementation.
+type MetaobjectResource struct {
+	client *shopify.Client
+}
+
+func NewMetaobjectResource() resource.Resource {
+	return &MetaobjectResource{}
+}
+
+// MetaobjectResourceModel describes the resource data model.
+type MetaobjectResourceModel struct {
+	ID           types.String                 `tfsdk:"id"`
+	Type         types.String                 `tfsdk:"type"`
+	Handle       types.String                 `tfsdk:"handle"`
+	Fields       map[string]types.String      `tfsdk:"fields"`
+	Capabilities *MetaobjectCapabilitiesModel `tfsdk:"capabilities"`
+}
+
+// MetaobjectCapabilitiesModel describes the metaobject capabilities data model.
+type MetaobjectCapabilitiesModel struct {
+	Publishable *MetaobjectCapabilitiesPublishableModel `tfsdk:"publishable"`
+}
+
+// MetaobjectCapabilitiesPublishableModel describes the metaobject publishable capability data model.
+type MetaobjectCapabilitiesPublishableModel struct {
+	Status types.String `tfsdk:"status"`
+}
+
+func (r *MetaobjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metaobject"
+}
+
+func (r *MetaobjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides an instance of a metaobject definition, e.g. an entry of a size chart or a store location.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique ID of the metaobject.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the metaobject definition that the metaobject belongs to.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"handle": schema.StringAttribute{
+				MarkdownDescription: "The unique handle of the metaobject within its type. Creating a metaobject fails when the handle is already used by another metaobject of the type, which can be imported instead.",
+				Required:            true,
+			},
+			"fields": schema.MapAttribute{
+				MarkdownDescription: "The field values of the metaobject, keyed by the field definition key. Values are serialized as strings in the same format as metafield values.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"capabilities": schema.SingleNestedAttribute{
+				MarkdownDescription: "The capabilities of the metaobject. Can only be set when the capability is enabled on the metaobject definition.",
+				Attributes: map[string]schema.Attribute{
+					"publishable": schema.SingleNestedAttribute{
+						MarkdownDescription: "The publishable capability of the metaobject.",
+						Attributes: map[string]schema.Attribute{
+							"status": schema.StringAttribute{
+								MarkdownDescription: "The publication status of the metaobject. Possible values are `ACTIVE` and `DRAFT`.",
+								Required:            true,
+							},
+						},
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *MetaobjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+// ModifyPlan validates the field keys against the referenced metaobject definition.
+func (r *MetaobjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Do not validate on resource destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var definitionType types.String
+	var fields types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &definitionType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("fields"), &fields)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if definitionType.IsUnknown() || fields.IsUnknown() {
+		return
+	}
+
+	definition, err := r.client.GetMetaobjectDefinitionByType(ctx, definitionType.ValueString())
+	// The definition may be created in the same apply.
+	if errors.Is(err, shopify.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metaobject definition, got error: %s", err))
+		return
+	}
+
+	for key := range fields.Elements() {
+		_, ok := xslice.FindBy(definition.FieldDefinitions, func(v *shopify.MetaobjectFieldDefinition) bool {
+			return v.Key == key
+		})
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("fields").AtMapKey(key),
+				"Unknown metaobject field",
+				fmt.Sprintf("The metaobject definition %q has no field definition with key %q.", definitionType.ValueString(), key),
+			)
+		}
+	}
+}
+
+func (r *MetaobjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MetaobjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The metaobject is created rather than upserted, so that an existing metaobject of the handle isn't taken over.
+	upsertInput := convertMetaobjectModelToUpsertInput(&data, nil)
+	createdMetaobject, err := r.client.CreateMetaobject(ctx, &shopify.MetaobjectCreateInput{
+		Type:         data.Type.ValueString(),
+		Handle:       upsertInput.Handle,
+		Fields:       upsertInput.Fields,
+		Capabilities: upsertInput.Capabilities,
+	})
+	if err != nil {
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "create metaobject", err, nil)
+		return
+	}
+
+	createdData := convertMetaobjectToResourceModel(createdMetaobject, &data)
+	tflog.Trace(ctx, "created a metaobject", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *MetaobjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MetaobjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metaobject, err := r.client.GetMetaobject(ctx, data.ID.ValueString())
+	if errors.Is(err, shopify.ErrNotFound) {
+		tflog.Warn(ctx, "metaobject not found, removing from state", map[string]interface{}{
+			"id": data.ID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metaobject, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertMetaobjectToResourceModel(metaobject, &data))...)
+}
+
+func (r *MetaobjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MetaobjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state MetaobjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The metaobject is looked up by the handle in the state so that the handle can be renamed.
+	handle := shopify.MetaobjectHandleInput{
+		Type:   state.Type.ValueString(),
+		Handle: state.Handle.ValueString(),
+	}
+	updatedMetaobject, err := r.client.UpsertMetaobject(ctx, &handle, convertMetaobjectModelToUpsertInput(&data, state.Fields))
+	if err != nil {
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "update metaobject", err, nil)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertMetaobjectToResourceModel(updatedMetaobject, &data))...)
+}
+
+func (r *MetaobjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MetaobjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMetaobject(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete metaobject, got error: %s", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted a metaobject", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *MetaobjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// convertMetaobjectModelToUpsertInput converts the model to the upsert input.
+// Fields which exist in oldFields but not in the model are cleared.
+func convertMetaobjectModelToUpsertInput(data *MetaobjectResourceModel, oldFields map[string]types.String) *shopify.MetaobjectUpsertInput {
+	fields := make([]*shopify.MetaobjectFieldInput, 0, len(data.Fields))
+	for key, value := range data.Fields {
+		fields = append(fields, &shopify.MetaobjectFieldInput{Key: key, Value: value.ValueString()})
+	}
+	for key := range oldFields {
+		if _, ok := data.Fields[key]; !ok {
+			fields = append(fields, &shopify.MetaobjectFieldInput{Key: key, Value: ""})
+		}
+	}
+
+	input := &shopify.MetaobjectUpsertInput{
+		Handle: data.Handle.ValueString(),
+		Fields: fields,
+	}
+	if data.Capabilities != nil && data.Capabilities.Publishable != nil {
+		input.Capabilities = &shopify.MetaobjectCapabilities{
+			Publishable: &shopify.MetaobjectCapabilitiesPublishable{
+				Status: data.Capabilities.Publishable.Status.ValueString(),
+			},
+		}
+	}
+	return input
+}
+
+func convertMetaobjectToResourceModel(metaobject *shopify.Metaobject, data *MetaobjectResourceModel) *MetaobjectResourceModel {
+	// Shopify returns all fields of the definition, so only the fields with a value are stored.
+	fields := make(map[string]types.String, len(metaobject.Fields))
+	for _, field := range metaobject.Fields {
+		if field.Value == nil {
+			// Shopify API handles empty string and null as the same value
+			if value, ok := data.Fields[field.Key]; ok && value.ValueString() == "" {
+				fields[field.Key] = value
+			}
+			continue
+		}
+		fields[field.Key] = types.StringValue(*field.Value)
+	}
+
+	// Capabilities are only stored when configured since Shopify returns them for every metaobject
+	// whose definition has the capability enabled.
+	var capabilities *MetaobjectCapabilitiesModel
+	if data.Capabilities != nil {
+		capabilities = &MetaobjectCapabilitiesModel{}
+		if data.Capabilities.Publishable != nil && metaobject.Capabilities != nil && metaobject.Capabilities.Publishable != nil {
+			capabilities.Publishable = &MetaobjectCapabilitiesPublishableModel{
+				Status: types.StringValue(metaobject.Capabilities.Publishable.Status),
+			}
+		}
+	}
+
+	return &MetaobjectResourceModel{
+		ID:           types.StringValue(metaobject.ID),
+		Type:         types.StringValue(metaobject.Type),
+		Handle:       types.StringValue(metaobject.Handle),
+		Fields:       fields,
+		Capabilities: capabilities,
+	}
+}
@@ -2,9 +2,13 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -16,17 +20,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
-	"github.com/k-yomo/terraform-provider-shopify/internal/utils"
 	"github.com/k-yomo/terraform-provider-shopify/pkg/xslice"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &MetaobjectDefinitionResource{}
 var _ resource.ResourceWithImportState = &MetaobjectDefinitionResource{}
+var _ resource.ResourceWithModifyPlan = &MetaobjectDefinitionResource{}
 
 // MetaobjectDefinitionResource defines the resource implementation.
 type MetaobjectDefinitionResource struct {
@@ -42,14 +47,59 @@ type MetaobjectFieldDefinitionResourceModel struct {
 
 // MetaobjectDefinitionResourceModel describes the resource data model.
 type MetaobjectDefinitionResourceModel struct {
-	ID                types.String                      `tfsdk:"id"`
-	Name              types.String                      `tfsdk:"name"`
-	Type              types.String                      `tfsdk:"type"`
-	Description       types.String                      `tfsdk:"description"`
-	DisplayNameKey    types.String                      `tfsdk:"display_name_key"`
-	FieldDefinitions  []*MetaobjectFieldDefinitionModel `tfsdk:"field_definitions"`
-	HasThumbnailField types.Bool                        `tfsdk:"has_thumbnail_field"`
-	Access            types.Object                      `tfsdk:"access"`
+	ID                types.String                           `tfsdk:"id"`
+	Name              types.String                           `tfsdk:"name"`
+	Type              types.String                           `tfsdk:"type"`
+	Description       types.String                           `tfsdk:"description"`
+	DisplayNameKey    types.String                           `tfsdk:"display_name_key"`
+	FieldDefinitions  []*MetaobjectFieldDefinitionModel      `tfsdk:"field_definitions"`
+	HasThumbnailField types.Bool                             `tfsdk:"has_thumbnail_field"`
+	Access            types.Object                           `tfsdk:"access"`
+	Capabilities      *MetaobjectDefinitionCapabilitiesModel `tfsdk:"capabilities"`
+	RollbackOnFailure types.Bool                             `tfsdk:"rollback_on_failure"`
+}
+
+// MetaobjectDefinitionCapabilitiesModel describes the metaobject definition capabilities data model.
+type MetaobjectDefinitionCapabilitiesModel struct {
+	Publishable  types.Bool                                      `tfsdk:"publishable"`
+	Translatable types.Bool                                      `tfsdk:"translatable"`
+	Renderable   *MetaobjectDefinitionRenderableCapabilityModel  `tfsdk:"renderable"`
+	OnlineStore  *MetaobjectDefinitionOnlineStoreCapabilityModel `tfsdk:"online_store"`
+}
+
+// MetaobjectDefinitionRenderableCapabilityModel describes the metaobject definition renderable capability data model.
+type MetaobjectDefinitionRenderableCapabilityModel struct {
+	MetaTitleKey       types.String `tfsdk:"meta_title_key"`
+	MetaDescriptionKey types.String `tfsdk:"meta_description_key"`
+}
+
+// MetaobjectDefinitionOnlineStoreCapabilityModel describes the metaobject definition online store capability data model.
+type MetaobjectDefinitionOnlineStoreCapabilityModel struct {
+	URLHandle       types.String `tfsdk:"url_handle"`
+	CreateRedirects types.Bool   `tfsdk:"create_redirects"`
+}
+
+// toShopifyInput returns the input enabling the configured capabilities and disabling the others.
+func (m *MetaobjectDefinitionCapabilitiesModel) toShopifyInput() *shopify.MetaobjectDefinitionCapabilitiesInput {
+	input := &shopify.MetaobjectDefinitionCapabilitiesInput{
+		Publishable:  &shopify.MetaobjectDefinitionCapability{Enabled: m.Publishable.ValueBool()},
+		Translatable: &shopify.MetaobjectDefinitionCapability{Enabled: m.Translatable.ValueBool()},
+		Renderable:   &shopify.MetaobjectDefinitionCapabilityRenderableInput{Enabled: m.Renderable != nil},
+		OnlineStore:  &shopify.MetaobjectDefinitionCapabilityOnlineStoreInput{Enabled: m.OnlineStore != nil},
+	}
+	if m.Renderable != nil {
+		input.Renderable.Data = &shopify.MetaobjectDefinitionCapabilityRenderableData{
+			MetaTitleKey:       m.Renderable.MetaTitleKey.ValueStringPointer(),
+			MetaDescriptionKey: m.Renderable.MetaDescriptionKey.ValueStringPointer(),
+		}
+	}
+	if m.OnlineStore != nil {
+		input.OnlineStore.Data = &shopify.MetaobjectDefinitionCapabilityOnlineStoreDataInput{
+			URLHandle:       m.OnlineStore.URLHandle.ValueString(),
+			CreateRedirects: m.OnlineStore.CreateRedirects.ValueBool(),
+		}
+	}
+	return input
 }
 
 type MetaobjectDefinitionAccessModel struct {
@@ -83,6 +133,8 @@ type MetaobjectFieldDefinitionModel struct {
 	Type        types.String                          `tfsdk:"type"`
 	Required    types.Bool                            `tfsdk:"required"`
 	Validations []*MetafieldDefinitionValidationModel `tfsdk:"validations"`
+	// AllowDestructiveTypeChange only affects how the changes are applied, so it's kept as configured.
+	AllowDestructiveTypeChange types.Bool `tfsdk:"allow_destructive_type_change"`
 }
 
 func (r *MetaobjectDefinitionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -135,19 +187,15 @@ Must be 3-64 characters long and only contain alphanumeric, hyphen, and undersco
 							Optional:            true,
 						},
 						"type": schema.StringAttribute{
-							MarkdownDescription: "The metafield type applied to values of the field. If the type is changed, the field will be recreated.",
+							MarkdownDescription: "The metafield type applied to values of the field. The type can be changed in place to a compatible type, such as from `single_line_text_field` to `multi_line_text_field` or to its list type. Changing it to an incompatible type recreates the field and requires `allow_destructive_type_change`.",
 							Required:            true,
-							PlanModifiers: []planmodifier.String{
-								utils.LogAttributeChangeModifier(func(ctx context.Context, req planmodifier.StringRequest) diag.Diagnostics {
-									return diag.Diagnostics{diag.NewWarningDiagnostic(
-										"Changing the type will recreate the field.",
-										"Changing the type of the field definition will recreate the field. It will delete the existing data associated with the field.",
-									)}
-								},
-									"Changing the type will recreate the field.",
-									"Changing the type will recreate the field.",
-								),
-							},
+							Validators:          []validator.String{metafieldTypeValidator{}},
+						},
+						"allow_destructive_type_change": schema.BoolAttribute{
+							MarkdownDescription: "Whether to allow changing `type` to an incompatible type, which recreates the field and deletes the values stored in it. Without it, such a change fails the plan. Defaults to `false`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
 						},
 						"required": schema.BoolAttribute{
 							MarkdownDescription: "Whether metaobjects require a saved value for the field.",
@@ -169,12 +217,20 @@ Must be 3-64 characters long and only contain alphanumeric, hyphen, and undersco
 									},
 								},
 							},
-							Optional: true,
+							Optional:   true,
+							Validators: []validator.List{metafieldValidationsValidator{}},
 						},
 					},
 				},
 				Required: true,
 			},
+			"rollback_on_failure": schema.BoolAttribute{
+				MarkdownDescription: "Whether to create the fields deleted to change their types again with the previous types when they can't be created with the new types. " +
+					"Otherwise, the definition is left without the fields and the next apply creates them. The values stored in the fields are deleted either way. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"has_thumbnail_field": schema.BoolAttribute{
 				MarkdownDescription: "Whether this metaobject definition has field whose type can visually represent a metaobject with the thumbnailField.",
 				Computed:            true,
@@ -202,6 +258,54 @@ Must be 3-64 characters long and only contain alphanumeric, hyphen, and undersco
 					objectplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"capabilities": schema.SingleNestedAttribute{
+				MarkdownDescription: "The capabilities of the metaobject definition. Capabilities not set here are disabled. If omitted, the capabilities are left unchanged.",
+				Attributes: map[string]schema.Attribute{
+					"publishable": schema.BoolAttribute{
+						MarkdownDescription: "Whether the metaobjects have a publication status of `ACTIVE` or `DRAFT`. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"translatable": schema.BoolAttribute{
+						MarkdownDescription: "Whether the metaobjects can be translated. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"renderable": schema.SingleNestedAttribute{
+						MarkdownDescription: "The renderable capability, which sets the SEO data of the metaobjects from their fields. Enabled when set.",
+						Attributes: map[string]schema.Attribute{
+							"meta_title_key": schema.StringAttribute{
+								MarkdownDescription: "The key of the field used as the meta title.",
+								Optional:            true,
+							},
+							"meta_description_key": schema.StringAttribute{
+								MarkdownDescription: "The key of the field used as the meta description.",
+								Optional:            true,
+							},
+						},
+						Optional: true,
+					},
+					"online_store": schema.SingleNestedAttribute{
+						MarkdownDescription: "The online store capability, which renders the metaobjects as web pages with a theme template. Enabled when set.",
+						Attributes: map[string]schema.Attribute{
+							"url_handle": schema.StringAttribute{
+								MarkdownDescription: "The URL handle of the web pages, e.g. `authors` for `/pages/authors/{handle}`.",
+								Required:            true,
+							},
+							"create_redirects": schema.BoolAttribute{
+								MarkdownDescription: "Whether to create redirects automatically when the URL handle is changed. Defaults to `false`.",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(false),
+							},
+						},
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
 		},
 	}
 }
@@ -214,6 +318,55 @@ func (r *MetaobjectDefinitionResource) Configure(ctx context.Context, req resour
 	r.client, _ = req.ProviderData.(*shopify.Client)
 }
 
+// ModifyPlan fails the plan when the type of a field is changed to an incompatible type without allow_destructive_type_change,
+// since such a change recreates the field and deletes the values stored in it.
+func (r *MetaobjectDefinitionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Do not check on resource creation or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state []*MetaobjectFieldDefinitionModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("field_definitions"), &plan)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("field_definitions"), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateFieldDefinitionMap := make(map[string]*MetaobjectFieldDefinitionModel, len(state))
+	for _, fieldDefinition := range state {
+		stateFieldDefinitionMap[fieldDefinition.Key.ValueString()] = fieldDefinition
+	}
+	for i, fieldDefinition := range plan {
+		if fieldDefinition.Key.IsUnknown() || fieldDefinition.Type.IsUnknown() {
+			continue
+		}
+		stateFieldDefinition, ok := stateFieldDefinitionMap[fieldDefinition.Key.ValueString()]
+		if !ok {
+			continue
+		}
+		oldType, newType := stateFieldDefinition.Type.ValueString(), fieldDefinition.Type.ValueString()
+		if shopify.CanMigrateMetafieldType(oldType, newType) {
+			continue
+		}
+
+		typePath := path.Root("field_definitions").AtListIndex(i).AtName("type")
+		if fieldDefinition.AllowDestructiveTypeChange.ValueBool() {
+			resp.Diagnostics.AddAttributeWarning(
+				typePath,
+				"Field Will Be Recreated",
+				fmt.Sprintf("Changing the type of the %q field from %s to %s recreates the field. The values stored in the field will be deleted.", fieldDefinition.Key.ValueString(), oldType, newType),
+			)
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			typePath,
+			"Incompatible Field Type Change",
+			fmt.Sprintf("The type of the %q field can't be changed from %s to %s without deleting the values stored in the field. "+
+				"Set allow_destructive_type_change = true on the field definition to recreate the field.", fieldDefinition.Key.ValueString(), oldType, newType),
+		)
+	}
+}
+
 func (r *MetaobjectDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data MetaobjectDefinitionResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -245,9 +398,12 @@ func (r *MetaobjectDefinitionResource) Create(ctx context.Context, req resource.
 		}
 		input.Access = access.toShopifyModel()
 	}
+	if data.Capabilities != nil {
+		input.Capabilities = data.Capabilities.toShopifyInput()
+	}
 	createdMetaobjectDefinition, err := r.client.CreateMetaobjectDefinition(ctx, &input)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create metaobject definition, got error: %s", err))
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "create metaobject definition", err, nil)
 		return
 	}
 
@@ -270,6 +426,13 @@ func (r *MetaobjectDefinitionResource) Read(ctx context.Context, req resource.Re
 	}
 
 	metaobjectDefinition, err := r.client.GetMetaobjectDefinition(ctx, data.ID.ValueString())
+	if errors.Is(err, shopify.ErrNotFound) {
+		tflog.Warn(ctx, "metaobject definition not found, removing from state", map[string]interface{}{
+			"id": data.ID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metaobject definition, got error: %s", err))
 		return
@@ -282,6 +445,11 @@ func (r *MetaobjectDefinitionResource) Read(ctx context.Context, req resource.Re
 	resp.Diagnostics.Append(resp.State.Set(ctx, metaobjectDefinitionModel)...)
 }
 
+// Update applies the changes in a single request, except when fields are recreated to change their types.
+// Since Shopify can't delete and create a field of the same key in a request, the recreated fields are deleted
+// in the first request with the other changes and created in the second one.
+// The state is saved after each request, so that a failure of the second request leaves the state matching
+// the definition in Shopify and the next apply creates the missing fields.
 func (r *MetaobjectDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data MetaobjectDefinitionResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -289,61 +457,20 @@ func (r *MetaobjectDefinitionResource) Update(ctx context.Context, req resource.
 		return
 	}
 
-	var oldFieldDefinitions []*MetaobjectFieldDefinitionModel
-	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("field_definitions"), &oldFieldDefinitions)...)
-	if resp.Diagnostics.HasError() {
+	// The operations are computed from the current definition rather than the state,
+	// so that retrying an update only applies the changes not applied yet.
+	currentDefinition, err := r.client.GetMetaobjectDefinition(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metaobject definition, got error: %s", err))
 		return
 	}
-	oldFieldDefinitionMap := make(map[string]*MetaobjectFieldDefinitionModel, len(oldFieldDefinitions))
-	for _, fieldDefinition := range oldFieldDefinitions {
-		oldFieldDefinitionMap[fieldDefinition.Key.ValueString()] = fieldDefinition
-	}
-
-	var fieldDefinitions1stReq []*shopify.MetaobjectFieldDefinitionOperationInput
-	var fieldDefinitions2ndReq []*shopify.MetaobjectFieldDefinitionOperationInput
-	var recreateFieldDefinitions []string
-	for _, newFieldDef := range data.FieldDefinitions {
-		if oldFieldDef, ok := oldFieldDefinitionMap[newFieldDef.Key.ValueString()]; ok {
-			delete(oldFieldDefinitionMap, newFieldDef.Key.ValueString())
-			if reflect.DeepEqual(oldFieldDef, newFieldDef) {
-				continue
-			}
-			if !newFieldDef.Type.Equal(oldFieldDef.Type) {
-				fieldDefinitions1stReq = append(fieldDefinitions1stReq, &shopify.MetaobjectFieldDefinitionOperationInput{
-					Delete: &shopify.MetaobjectFieldDefinitionDeleteInput{
-						Key: oldFieldDef.Key.ValueString(),
-					},
-				})
-				fieldDefinitions2ndReq = append(fieldDefinitions2ndReq, &shopify.MetaobjectFieldDefinitionOperationInput{
-					Create: convertMetaobjectFieldDefinitionModelToCreateInput(newFieldDef),
-				})
-				recreateFieldDefinitions = append(recreateFieldDefinitions, newFieldDef.Key.ValueString())
-			} else {
-				fieldDefinitions1stReq = append(fieldDefinitions1stReq, &shopify.MetaobjectFieldDefinitionOperationInput{
-					Update: &shopify.MetaobjectFieldDefinitionUpdateInput{
-						Key:         newFieldDef.Key.ValueString(),
-						Name:        newFieldDef.Name.ValueStringPointer(),
-						Description: newFieldDef.Description.ValueStringPointer(),
-						Required:    newFieldDef.Required.ValueBool(),
-						Validations: convertValidationModelsToValidations(newFieldDef.Validations),
-					},
-				})
-			}
-		} else {
-			fieldDefinitions1stReq = append(fieldDefinitions1stReq, &shopify.MetaobjectFieldDefinitionOperationInput{
-				Create: convertMetaobjectFieldDefinitionModelToCreateInput(newFieldDef),
-			})
-		}
-	}
-	if len(recreateFieldDefinitions) > 0 {
-		tflog.Warn(ctx, "")
+	operations, diags := buildFieldDefinitionOperations(currentDefinition.FieldDefinitions, data.FieldDefinitions)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
 	}
-
-	for _, oldFieldDef := range oldFieldDefinitionMap {
-		fieldDefinitions1stReq = append(fieldDefinitions1stReq, &shopify.MetaobjectFieldDefinitionOperationInput{
-			Delete: &shopify.MetaobjectFieldDefinitionDeleteInput{
-				Key: oldFieldDef.Key.ValueString(),
-			},
+	if len(operations.recreatedKeys) > 0 {
+		tflog.Warn(ctx, "recreating field definitions to change the type", map[string]interface{}{
+			"keys": operations.recreatedKeys,
 		})
 	}
 
@@ -355,7 +482,7 @@ func (r *MetaobjectDefinitionResource) Update(ctx context.Context, req resource.
 		Name:             data.Name.ValueString(),
 		Description:      data.Description.ValueStringPointer(),
 		DisplayNameKey:   displayNameKey,
-		FieldDefinitions: fieldDefinitions1stReq,
+		FieldDefinitions: operations.firstPhase,
 	}
 	if !data.Access.IsNull() && !data.Access.IsUnknown() {
 		var access MetaobjectDefinitionAccessModel
@@ -365,35 +492,199 @@ func (r *MetaobjectDefinitionResource) Update(ctx context.Context, req resource.
 		}
 		input1stReq.Access = access.toShopifyModel()
 	}
+	if data.Capabilities != nil {
+		input1stReq.Capabilities = data.Capabilities.toShopifyInput()
+	}
 	updatedMetaobjectDefinition, err := r.client.UpdateMetaobjectDefinition(ctx, data.ID.ValueString(), &input1stReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update metaobject definition, got error: %s", err))
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "update metaobject definition", err, fieldDefinitionOperationFieldRewriter(operations.firstPhase, data.FieldDefinitions))
 		return
 	}
 	updateData, diags := convertMetaobjectDefinitionToResourceModel(ctx, updatedMetaobjectDefinition, &data)
 	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, updateData)...)
+	if resp.Diagnostics.HasError() || len(operations.secondPhase) == 0 {
+		return
+	}
+
+	input2ndReq := shopify.MetaobjectDefinitionUpdateInput{
+		Name:             data.Name.ValueString(),
+		Description:      data.Description.ValueStringPointer(),
+		DisplayNameKey:   displayNameKey,
+		FieldDefinitions: operations.secondPhase,
+	}
+	updatedMetaobjectDefinition, err = r.client.UpdateMetaobjectDefinition(ctx, data.ID.ValueString(), &input2ndReq)
+	if err != nil {
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "update metaobject definition", err, fieldDefinitionOperationFieldRewriter(operations.secondPhase, data.FieldDefinitions))
+		if data.RollbackOnFailure.ValueBool() {
+			r.rollbackFieldDefinitionRecreation(ctx, currentDefinition, operations.recreatedKeys, &input2ndReq, &data, resp)
+			return
+		}
+		resp.Diagnostics.AddWarning(
+			"Metaobject Definition Partially Updated",
+			fmt.Sprintf("The field definitions %s were deleted to change their types, but couldn't be created again. "+
+				"The other changes have been applied and saved to the state, and the next apply will create the field definitions.", strings.Join(operations.recreatedKeys, ", ")),
+		)
+		return
+	}
+	updateData, diags = convertMetaobjectDefinitionToResourceModel(ctx, updatedMetaobjectDefinition, &data)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, updateData)...)
+}
 
-	if len(fieldDefinitions2ndReq) > 0 {
-		input2ndReq := shopify.MetaobjectDefinitionUpdateInput{
-			Name:             data.Name.ValueString(),
-			Description:      data.Description.ValueStringPointer(),
-			DisplayNameKey:   displayNameKey,
-			FieldDefinitions: fieldDefinitions2ndReq,
+// rollbackFieldDefinitionRecreation creates the field definitions deleted for the recreation again as they were before the update,
+// so that the definition keeps its fields when they couldn't be created with the new types.
+// The values stored in the fields are not restored since Shopify has deleted them with the fields.
+func (r *MetaobjectDefinitionResource) rollbackFieldDefinitionRecreation(ctx context.Context, previousDefinition *shopify.MetaobjectDefinition, recreatedKeys []string, input *shopify.MetaobjectDefinitionUpdateInput, data *MetaobjectDefinitionResourceModel, resp *resource.UpdateResponse) {
+	rollbackInput := *input
+	rollbackInput.FieldDefinitions = nil
+	for _, fieldDefinition := range previousDefinition.FieldDefinitions {
+		if slices.Contains(recreatedKeys, fieldDefinition.Key) {
+			rollbackInput.FieldDefinitions = append(rollbackInput.FieldDefinitions, &shopify.MetaobjectFieldDefinitionOperationInput{
+				Create: convertMetaobjectFieldDefinitionToCreateInput(fieldDefinition),
+			})
 		}
-		updatedMetaobjectDefinition, err := r.client.UpdateMetaobjectDefinition(ctx, data.ID.ValueString(), &input2ndReq)
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update metaobject definition, got error: %s", err))
-			return
+	}
+	rolledBackDefinition, err := r.client.UpdateMetaobjectDefinition(ctx, data.ID.ValueString(), &rollbackInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Rollback Failed",
+			fmt.Sprintf("The field definitions %s were deleted to change their types, and couldn't be created again with either the new or the previous types. "+
+				"The next apply will create the field definitions with the new types. Got error: %s", strings.Join(recreatedKeys, ", "), err),
+		)
+		return
+	}
+	tflog.Warn(ctx, "rolled back the recreation of field definitions", map[string]interface{}{
+		"keys": recreatedKeys,
+	})
+	// resp already has the error of the second request, so only the errors of the conversion are checked.
+	rolledBackData, diags := convertMetaobjectDefinitionToResourceModel(ctx, rolledBackDefinition, data)
+	if resp.Diagnostics.Append(diags...); diags.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, rolledBackData)...)
+	resp.Diagnostics.AddWarning(
+		"Field Definition Recreation Rolled Back",
+		fmt.Sprintf("The field definitions %s have been created again with the previous types. The values stored in them before the update were deleted.", strings.Join(recreatedKeys, ", ")),
+	)
+}
+
+// fieldDefinitionOperations are the operations to change the field definitions into the planned ones.
+type fieldDefinitionOperations struct {
+	firstPhase []*shopify.MetaobjectFieldDefinitionOperationInput
+	// secondPhase creates the fields deleted in the first phase to change their types.
+	secondPhase   []*shopify.MetaobjectFieldDefinitionOperationInput
+	recreatedKeys []string
+}
+
+// buildFieldDefinitionOperations returns the operations to change the current field definitions into the planned ones.
+// Types are changed in place when compatible, otherwise the fields are recreated if allow_destructive_type_change is set.
+func buildFieldDefinitionOperations(current []*shopify.MetaobjectFieldDefinition, planned []*MetaobjectFieldDefinitionModel) (*fieldDefinitionOperations, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	currentFieldDefinitionMap := make(map[string]*shopify.MetaobjectFieldDefinition, len(current))
+	for _, fieldDefinition := range current {
+		currentFieldDefinitionMap[fieldDefinition.Key] = fieldDefinition
+	}
+
+	operations := &fieldDefinitionOperations{}
+	for i, newFieldDef := range planned {
+		key := newFieldDef.Key.ValueString()
+		currentFieldDef, ok := currentFieldDefinitionMap[key]
+		if !ok {
+			operations.firstPhase = append(operations.firstPhase, &shopify.MetaobjectFieldDefinitionOperationInput{
+				Create: convertMetaobjectFieldDefinitionModelToCreateInput(newFieldDef),
+			})
+			continue
 		}
-		updateData, diags = convertMetaobjectDefinitionToResourceModel(ctx, updatedMetaobjectDefinition, &data)
-		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
-			return
+		delete(currentFieldDefinitionMap, key)
+		if reflect.DeepEqual(convertMetaobjectFieldDefinitionToModel(currentFieldDef, newFieldDef), newFieldDef) {
+			continue
+		}
+
+		currentType, newType := currentFieldDef.Type.Name, newFieldDef.Type.ValueString()
+		if !shopify.CanMigrateMetafieldType(currentType, newType) {
+			// ModifyPlan has checked the opt-in against the state, which may be outdated.
+			if !newFieldDef.AllowDestructiveTypeChange.ValueBool() {
+				diags.AddAttributeError(
+					path.Root("field_definitions").AtListIndex(i).AtName("type"),
+					"Incompatible Field Type Change",
+					fmt.Sprintf("The type of the %q field can't be changed from %s to %s without deleting the values stored in the field. "+
+						"Set allow_destructive_type_change = true on the field definition to recreate the field.", key, currentType, newType),
+				)
+				continue
+			}
+			operations.firstPhase = append(operations.firstPhase, &shopify.MetaobjectFieldDefinitionOperationInput{
+				Delete: &shopify.MetaobjectFieldDefinitionDeleteInput{Key: key},
+			})
+			operations.secondPhase = append(operations.secondPhase, &shopify.MetaobjectFieldDefinitionOperationInput{
+				Create: convertMetaobjectFieldDefinitionModelToCreateInput(newFieldDef),
+			})
+			operations.recreatedKeys = append(operations.recreatedKeys, key)
+			continue
+		}
+
+		updateInput := &shopify.MetaobjectFieldDefinitionUpdateInput{
+			Key:         key,
+			Name:        newFieldDef.Name.ValueStringPointer(),
+			Description: newFieldDef.Description.ValueStringPointer(),
+			Required:    newFieldDef.Required.ValueBool(),
+			Validations: convertValidationModelsToValidations(newFieldDef.Validations),
+		}
+		if currentType != newType {
+			updateInput.Type = &newType
+		}
+		operations.firstPhase = append(operations.firstPhase, &shopify.MetaobjectFieldDefinitionOperationInput{
+			Update: updateInput,
+		})
+	}
+
+	// Delete in the current order to send the same request on retries.
+	for _, fieldDefinition := range current {
+		if _, ok := currentFieldDefinitionMap[fieldDefinition.Key]; ok {
+			operations.firstPhase = append(operations.firstPhase, &shopify.MetaobjectFieldDefinitionOperationInput{
+				Delete: &shopify.MetaobjectFieldDefinitionDeleteInput{Key: fieldDefinition.Key},
+			})
 		}
 	}
+	return operations, diags
+}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &updateData)...)
+// fieldDefinitionOperationFieldRewriter maps the index of the field definition operations in the field of user errors
+// into the index of `field_definitions`, e.g. ["definition", "fieldDefinitions", "0", "create", "key"] into ["definition", "fieldDefinitions", "2", "key"].
+// Errors of the delete operations are reported without attribute paths since the field definitions are no longer in the plan.
+func fieldDefinitionOperationFieldRewriter(operations []*shopify.MetaobjectFieldDefinitionOperationInput, fieldDefinitions []*MetaobjectFieldDefinitionModel) userErrorFieldRewriter {
+	return func(field []string) []string {
+		if len(field) < 3 || field[1] != "fieldDefinitions" {
+			return field
+		}
+		operationIndex, err := strconv.Atoi(field[2])
+		if err != nil || operationIndex < 0 || operationIndex >= len(operations) {
+			return nil
+		}
+		var key string
+		switch operation := operations[operationIndex]; {
+		case operation.Create != nil:
+			key = operation.Create.Key
+		case operation.Update != nil:
+			key = operation.Update.Key
+		default:
+			return nil
+		}
+		rest := field[3:]
+		if len(rest) > 0 && (rest[0] == "create" || rest[0] == "update") {
+			rest = rest[1:]
+		}
+		for i, fieldDefinition := range fieldDefinitions {
+			if fieldDefinition.Key.ValueString() == key {
+				return append([]string{field[0], field[1], strconv.Itoa(i)}, rest...)
+			}
+		}
+		return nil
+	}
 }
 
 func (r *MetaobjectDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -413,8 +704,26 @@ func (r *MetaobjectDefinitionResource) Delete(ctx context.Context, req resource.
 	})
 }
 
+// ImportState imports the metaobject definition by either its global ID or its type.
 func (r *MetaobjectDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if strings.HasPrefix(req.ID, "gid://") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	definition, err := r.client.GetMetaobjectDefinitionByType(ctx, req.ID)
+	if errors.Is(err, shopify.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Metaobject Definition Not Found",
+			fmt.Sprintf("No metaobject definition found for type %q.", req.ID),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metaobject definition, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), definition.ID)...)
 }
 
 func convertMetaobjectDefinitionToResourceModel(ctx context.Context, definition *shopify.MetaobjectDefinition, data *MetaobjectDefinitionResourceModel) (*MetaobjectDefinitionResourceModel, diag.Diagnostics) {
@@ -435,7 +744,7 @@ func convertMetaobjectDefinitionToResourceModel(ctx context.Context, definition
 	for i, fieldDefinition := range data.FieldDefinitions {
 		fieldDefinitionOrderMap[fieldDefinition.Key.ValueString()] = i
 	}
-	sort.Slice(fieldDefinitionModels, func(i, j int) bool {
+	sort.SliceStable(fieldDefinitionModels, func(i, j int) bool {
 		return fieldDefinitionOrderMap[fieldDefinitionModels[i].Key.ValueString()] < fieldDefinitionOrderMap[fieldDefinitionModels[j].Key.ValueString()]
 	})
 
@@ -446,6 +755,18 @@ func convertMetaobjectDefinitionToResourceModel(ctx context.Context, definition
 		description = types.StringNull()
 	}
 
+	// Capabilities are only stored when configured not to manage the ones enabled in the admin.
+	var capabilities *MetaobjectDefinitionCapabilitiesModel
+	if data.Capabilities != nil && definition.Capabilities != nil {
+		capabilities = convertMetaobjectDefinitionCapabilitiesToModel(definition.Capabilities)
+	}
+
+	// rollback_on_failure only affects how the changes are applied, so it's kept as configured.
+	rollbackOnFailure := types.BoolValue(false)
+	if !data.RollbackOnFailure.IsNull() && !data.RollbackOnFailure.IsUnknown() {
+		rollbackOnFailure = data.RollbackOnFailure
+	}
+
 	return &MetaobjectDefinitionResourceModel{
 		ID:                types.StringValue(definition.ID),
 		Name:              types.StringValue(definition.Name),
@@ -455,9 +776,35 @@ func convertMetaobjectDefinitionToResourceModel(ctx context.Context, definition
 		FieldDefinitions:  fieldDefinitionModels,
 		HasThumbnailField: types.BoolValue(definition.HasThumbnailField),
 		Access:            access,
+		Capabilities:      capabilities,
+		RollbackOnFailure: rollbackOnFailure,
 	}, nil
 }
 
+func convertMetaobjectDefinitionCapabilitiesToModel(capabilities *shopify.MetaobjectDefinitionCapabilities) *MetaobjectDefinitionCapabilitiesModel {
+	model := &MetaobjectDefinitionCapabilitiesModel{
+		Publishable:  types.BoolValue(capabilities.Publishable.Enabled),
+		Translatable: types.BoolValue(capabilities.Translatable.Enabled),
+	}
+	if capabilities.Renderable.Enabled {
+		model.Renderable = &MetaobjectDefinitionRenderableCapabilityModel{
+			MetaTitleKey:       types.StringNull(),
+			MetaDescriptionKey: types.StringNull(),
+		}
+		if data := capabilities.Renderable.Data; data != nil {
+			model.Renderable.MetaTitleKey = types.StringPointerValue(data.MetaTitleKey)
+			model.Renderable.MetaDescriptionKey = types.StringPointerValue(data.MetaDescriptionKey)
+		}
+	}
+	if capabilities.OnlineStore.Enabled && capabilities.OnlineStore.Data != nil {
+		model.OnlineStore = &MetaobjectDefinitionOnlineStoreCapabilityModel{
+			URLHandle:       types.StringValue(capabilities.OnlineStore.Data.URLHandle),
+			CreateRedirects: types.BoolValue(capabilities.OnlineStore.Data.CanCreateRedirects),
+		}
+	}
+	return model
+}
+
 func convertAccessToModel(access *shopify.MetaobjectAccess) *MetaobjectDefinitionAccessModel {
 	return &MetaobjectDefinitionAccessModel{
 		Admin:      types.StringValue(access.Admin),
@@ -470,13 +817,18 @@ func convertMetaobjectFieldDefinitionToModel(definition *shopify.MetaobjectField
 	if definition.Description == "" && model != nil && model.Description.IsNull() {
 		description = types.StringNull()
 	}
+	allowDestructiveTypeChange := types.BoolValue(false)
+	if model != nil && !model.AllowDestructiveTypeChange.IsNull() {
+		allowDestructiveTypeChange = model.AllowDestructiveTypeChange
+	}
 	return &MetaobjectFieldDefinitionModel{
-		Key:         types.StringValue(definition.Key),
-		Name:        types.StringValue(definition.Name),
-		Description: description,
-		Type:        types.StringValue(definition.Type.Name),
-		Required:    types.BoolValue(definition.Required),
-		Validations: convertValidationsToModels(definition.Validations),
+		Key:                        types.StringValue(definition.Key),
+		Name:                       types.StringValue(definition.Name),
+		Description:                description,
+		Type:                       types.StringValue(definition.Type.Name),
+		Required:                   types.BoolValue(definition.Required),
+		Validations:                convertValidationsToModels(definition.Validations),
+		AllowDestructiveTypeChange: allowDestructiveTypeChange,
 	}
 }
 
@@ -490,3 +842,14 @@ func convertMetaobjectFieldDefinitionModelToCreateInput(model *MetaobjectFieldDe
 		Validations: convertValidationModelsToValidations(model.Validations),
 	}
 }
+
+func convertMetaobjectFieldDefinitionToCreateInput(definition *shopify.MetaobjectFieldDefinition) *shopify.MetaobjectFieldDefinitionCreateInput {
+	return &shopify.MetaobjectFieldDefinitionCreateInput{
+		Key:         definition.Key,
+		Name:        &definition.Name,
+		Description: &definition.Description,
+		Type:        definition.Type.Name,
+		Required:    definition.Required,
+		Validations: definition.Validations,
+	}
+}
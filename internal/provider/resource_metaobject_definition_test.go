@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -10,7 +11,7 @@ import (
 func TestAccMetaobjectDefinitionResource(t *testing.T) {
 	metaobjectType := randResourceID(64)
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { testAccPreCheckAllowFake(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// Create and Read testing
@@ -24,6 +25,7 @@ func TestAccMetaobjectDefinitionResource(t *testing.T) {
 					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "field_definitions.0.name", "Name"),
 					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "field_definitions.0.type", "single_line_text_field"),
 					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "field_definitions.0.required", "true"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "rollback_on_failure", "false"),
 				),
 			},
 			// ImportState testing
@@ -31,6 +33,12 @@ func TestAccMetaobjectDefinitionResource(t *testing.T) {
 				ResourceName: "shopify_metaobject_definition.author",
 				ImportState:  true,
 			},
+			// ImportState testing by type
+			{
+				ResourceName:  "shopify_metaobject_definition.author",
+				ImportState:   true,
+				ImportStateId: metaobjectType,
+			},
 			//// Update and Read testing
 			{
 				Config: testAccMetaobjectDefinitionResourceUpdateConfig(metaobjectType),
@@ -41,6 +49,42 @@ func TestAccMetaobjectDefinitionResource(t *testing.T) {
 					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "field_definitions.0.validations.0.value", "10"),
 					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "access.admin", "PUBLIC_READ_WRITE"),
 					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "access.storefront", "PUBLIC_READ"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "capabilities.publishable", "true"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "capabilities.translatable", "false"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "capabilities.renderable.meta_title_key", "name"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "capabilities.online_store.url_handle", "authors"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "capabilities.online_store.create_redirects", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaobjectDefinitionResource_typeChange(t *testing.T) {
+	metaobjectType := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAllowFake(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetaobjectDefinitionResourceTypeChangeConfig(metaobjectType, "single_line_text_field", false),
+			},
+			// Compatible types are migrated in place
+			{
+				Config: testAccMetaobjectDefinitionResourceTypeChangeConfig(metaobjectType, "multi_line_text_field", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.test", "field_definitions.0.type", "multi_line_text_field"),
+				),
+			},
+			// Incompatible types require the opt-in
+			{
+				Config:      testAccMetaobjectDefinitionResourceTypeChangeConfig(metaobjectType, "number_integer", false),
+				ExpectError: regexp.MustCompile(`Incompatible Field Type Change`),
+			},
+			{
+				Config: testAccMetaobjectDefinitionResourceTypeChangeConfig(metaobjectType, "number_integer", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.test", "field_definitions.0.type", "number_integer"),
 				),
 			},
 		},
@@ -92,6 +136,7 @@ resource "shopify_metaobject_definition" "author" {
 	  name     = "Profile Image URL"
 	  type     = "url"
       required = true
+      allow_destructive_type_change = true
     },
     {
       key      = "bio"
@@ -102,6 +147,33 @@ resource "shopify_metaobject_definition" "author" {
   access = {
 	storefront = "PUBLIC_READ"
   }
+  capabilities = {
+    publishable = true
+    renderable = {
+      meta_title_key = "name"
+    }
+    online_store = {
+      url_handle       = "authors"
+      create_redirects = true
+    }
+  }
 }
 `, metaobjectType)
 }
+
+func testAccMetaobjectDefinitionResourceTypeChangeConfig(metaobjectType string, fieldType string, allowDestructiveTypeChange bool) string {
+	return fmt.Sprintf(`
+resource "shopify_metaobject_definition" "test" {
+  name = "Terraform Test"
+  type = %[1]q
+  field_definitions = [
+    {
+      key                           = "value"
+      name                          = "Value"
+      type                          = %[2]q
+      allow_destructive_type_change = %[3]t
+    }
+  ]
+}
+`, metaobjectType, fieldType, allowDestructiveTypeChange)
+}
@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify/shopifytest"
+)
+
+// The tests of the updates recreating fields call the resource directly against the fake server,
+// since the failures of the second request are injected into the fake and the state saved by the failed apply
+// can't be observed through the Terraform CLI, which refreshes the state before planning.
+
+// failUpdateRequests returns the failure hook failing the UpdateMetaobjectDefinition requests of the given numbers counted from 1,
+// and the function returning the variables of all the requests made.
+func failUpdateRequests(numbers ...int) (shopifytest.FailureHook, func() []json.RawMessage) {
+	var requests []json.RawMessage
+	hook := func(operationName string, variables json.RawMessage) error {
+		if operationName != "UpdateMetaobjectDefinition" {
+			return nil
+		}
+		requests = append(requests, variables)
+		for _, number := range numbers {
+			if len(requests) == number {
+				return errors.New("injected failure")
+			}
+		}
+		return nil
+	}
+	return hook, func() []json.RawMessage { return requests }
+}
+
+type metaobjectDefinitionTestResource struct {
+	resource *MetaobjectDefinitionResource
+	schema   resource.SchemaResponse
+}
+
+// newMetaobjectDefinitionTestResource creates a metaobject definition having the "title" and "value" fields
+// and returns the resource with the state.
+func newMetaobjectDefinitionTestResource(t *testing.T, client *shopify.Client, rollbackOnFailure bool) (*metaobjectDefinitionTestResource, tfsdk.State) {
+	t.Helper()
+	ctx := context.Background()
+	r := &metaobjectDefinitionTestResource{resource: &MetaobjectDefinitionResource{client: client}}
+	r.resource.Schema(ctx, resource.SchemaRequest{}, &r.schema)
+
+	plan := r.plan(t, testMetaobjectDefinitionModel("Before", "single_line_text_field", rollbackOnFailure))
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: r.schema.Schema, Raw: plan.Raw}}
+	r.resource.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create() diagnostics: %v", resp.Diagnostics)
+	}
+	return r, resp.State
+}
+
+func (r *metaobjectDefinitionTestResource) plan(t *testing.T, model *MetaobjectDefinitionResourceModel) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+	plan := tfsdk.Plan{Schema: r.schema.Schema, Raw: tftypes.NewValue(r.schema.Schema.Type().TerraformType(ctx), nil)}
+	if diags := plan.Set(ctx, model); diags.HasError() {
+		t.Fatalf("Set() diagnostics: %v", diags)
+	}
+	return plan
+}
+
+func (r *metaobjectDefinitionTestResource) update(t *testing.T, state tfsdk.State, model *MetaobjectDefinitionResourceModel) (*MetaobjectDefinitionResourceModel, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	plan := r.plan(t, model)
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: r.schema.Schema, Raw: plan.Raw}}
+	r.resource.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+
+	var data MetaobjectDefinitionResourceModel
+	if diags := resp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("Get() diagnostics: %v", diags)
+	}
+	return &data, resp.Diagnostics
+}
+
+func (r *metaobjectDefinitionTestResource) state(t *testing.T, model *MetaobjectDefinitionResourceModel) tfsdk.State {
+	t.Helper()
+	state := tfsdk.State{Schema: r.schema.Schema, Raw: tftypes.NewValue(r.schema.Schema.Type().TerraformType(context.Background()), nil)}
+	if diags := state.Set(context.Background(), model); diags.HasError() {
+		t.Fatalf("Set() diagnostics: %v", diags)
+	}
+	return state
+}
+
+// testMetaobjectDefinitionModel returns the planned model changing the type of the "value" field with the opt-in.
+func testMetaobjectDefinitionModel(name string, valueType string, rollbackOnFailure bool) *MetaobjectDefinitionResourceModel {
+	return &MetaobjectDefinitionResourceModel{
+		ID:             types.StringUnknown(),
+		Name:           types.StringValue(name),
+		Type:           types.StringValue("terraform_test"),
+		Description:    types.StringNull(),
+		DisplayNameKey: types.StringNull(),
+		FieldDefinitions: []*MetaobjectFieldDefinitionModel{
+			{
+				Key:                        types.StringValue("title"),
+				Name:                       types.StringValue("Title"),
+				Description:                types.StringNull(),
+				Type:                       types.StringValue("single_line_text_field"),
+				Required:                   types.BoolValue(false),
+				AllowDestructiveTypeChange: types.BoolValue(false),
+			},
+			{
+				Key:                        types.StringValue("value"),
+				Name:                       types.StringValue("Value"),
+				Description:                types.StringNull(),
+				Type:                       types.StringValue(valueType),
+				Required:                   types.BoolValue(false),
+				AllowDestructiveTypeChange: types.BoolValue(true),
+			},
+		},
+		HasThumbnailField: types.BoolUnknown(),
+		Access: types.ObjectUnknown(map[string]attr.Type{
+			"admin":      types.StringType,
+			"storefront": types.StringType,
+		}),
+		RollbackOnFailure: types.BoolValue(rollbackOnFailure),
+	}
+}
+
+// withID returns the planned model of the existing definition.
+func withID(model *MetaobjectDefinitionResourceModel, state *MetaobjectDefinitionResourceModel) *MetaobjectDefinitionResourceModel {
+	model.ID = state.ID
+	model.HasThumbnailField = state.HasThumbnailField
+	model.Access = state.Access
+	return model
+}
+
+func fieldDefinitionTypes(fieldDefinitions []*MetaobjectFieldDefinitionModel) map[string]string {
+	fieldTypes := map[string]string{}
+	for _, fieldDefinition := range fieldDefinitions {
+		fieldTypes[fieldDefinition.Key.ValueString()] = fieldDefinition.Type.ValueString()
+	}
+	return fieldTypes
+}
+
+func shopifyFieldDefinitionTypes(fieldDefinitions []*shopify.MetaobjectFieldDefinition) map[string]string {
+	fieldTypes := map[string]string{}
+	for _, fieldDefinition := range fieldDefinitions {
+		fieldTypes[fieldDefinition.Key] = fieldDefinition.Type.Name
+	}
+	return fieldTypes
+}
+
+func hasDiagnostic(diags diag.Diagnostics, severity diag.Severity, summary string) bool {
+	for _, d := range diags {
+		if d.Severity() == severity && d.Summary() == summary {
+			return true
+		}
+	}
+	return false
+}
+
+func assertFieldDefinitionTypes(t *testing.T, name string, got map[string]string, want map[string]string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("%s field definitions = %v, want %v", name, got, want)
+		return
+	}
+	for key, fieldType := range want {
+		if got[key] != fieldType {
+			t.Errorf("%s field definitions = %v, want %v", name, got, want)
+			return
+		}
+	}
+}
+
+func TestMetaobjectDefinitionResource_Update_secondRequestFailure(t *testing.T) {
+	ctx := context.Background()
+	server, client := newFakeClient(t)
+	r, state := newMetaobjectDefinitionTestResource(t, client, false)
+	var data MetaobjectDefinitionResourceModel
+	if diags := state.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("Get() diagnostics: %v", diags)
+	}
+
+	// The second request creating the field with the new type fails.
+	hook, _ := failUpdateRequests(2)
+	server.SetFailureHook(hook)
+	partialData, diags := r.update(t, state, withID(testMetaobjectDefinitionModel("After", "number_integer", false), &data))
+	if !hasDiagnostic(diags, diag.SeverityError, "Client Error") {
+		t.Errorf("Update() diagnostics = %v, want the error of the second request", diags)
+	}
+	if !hasDiagnostic(diags, diag.SeverityWarning, "Metaobject Definition Partially Updated") {
+		t.Errorf("Update() diagnostics = %v, want the partial update warning", diags)
+	}
+	// The state is saved with the changes of the first request.
+	if got := partialData.Name.ValueString(); got != "After" {
+		t.Errorf("saved name = %q, want %q", got, "After")
+	}
+	assertFieldDefinitionTypes(t, "saved", fieldDefinitionTypes(partialData.FieldDefinitions), map[string]string{"title": "single_line_text_field"})
+
+	// The next apply only creates the missing field.
+	hook, requests := failUpdateRequests()
+	server.SetFailureHook(hook)
+	retriedData, diags := r.update(t, r.state(t, partialData), withID(testMetaobjectDefinitionModel("After", "number_integer", false), partialData))
+	if diags.HasError() {
+		t.Fatalf("Update() diagnostics: %v", diags)
+	}
+	if got := len(requests()); got != 1 {
+		t.Errorf("retried update made %d requests, want 1", got)
+	}
+	want := map[string]string{"title": "single_line_text_field", "value": "number_integer"}
+	assertFieldDefinitionTypes(t, "retried", fieldDefinitionTypes(retriedData.FieldDefinitions), want)
+
+	// Applying the same plan again doesn't change the definition.
+	_, diags = r.update(t, r.state(t, retriedData), withID(testMetaobjectDefinitionModel("After", "number_integer", false), retriedData))
+	if diags.HasError() {
+		t.Fatalf("Update() diagnostics: %v", diags)
+	}
+	definition, err := client.GetMetaobjectDefinition(ctx, data.ID.ValueString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertFieldDefinitionTypes(t, "shopify", shopifyFieldDefinitionTypes(definition.FieldDefinitions), want)
+}
+
+func TestMetaobjectDefinitionResource_Update_rollback(t *testing.T) {
+	ctx := context.Background()
+	server, client := newFakeClient(t)
+	r, state := newMetaobjectDefinitionTestResource(t, client, true)
+	var data MetaobjectDefinitionResourceModel
+	if diags := state.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("Get() diagnostics: %v", diags)
+	}
+
+	hook, requests := failUpdateRequests(2)
+	server.SetFailureHook(hook)
+	rolledBackData, diags := r.update(t, state, withID(testMetaobjectDefinitionModel("After", "number_integer", true), &data))
+	if !hasDiagnostic(diags, diag.SeverityError, "Client Error") {
+		t.Errorf("Update() diagnostics = %v, want the error of the second request", diags)
+	}
+	if !hasDiagnostic(diags, diag.SeverityWarning, "Field Definition Recreation Rolled Back") {
+		t.Errorf("Update() diagnostics = %v, want the rollback warning", diags)
+	}
+	if got := len(requests()); got != 3 {
+		t.Errorf("update made %d requests, want 3 including the rollback", got)
+	}
+
+	want := map[string]string{"title": "single_line_text_field", "value": "single_line_text_field"}
+	assertFieldDefinitionTypes(t, "saved", fieldDefinitionTypes(rolledBackData.FieldDefinitions), want)
+	definition, err := client.GetMetaobjectDefinition(ctx, data.ID.ValueString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertFieldDefinitionTypes(t, "shopify", shopifyFieldDefinitionTypes(definition.FieldDefinitions), want)
+}
+
+func TestMetaobjectDefinitionResource_Update_rollbackFailure(t *testing.T) {
+	ctx := context.Background()
+	server, client := newFakeClient(t)
+	r, state := newMetaobjectDefinitionTestResource(t, client, true)
+	var data MetaobjectDefinitionResourceModel
+	if diags := state.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("Get() diagnostics: %v", diags)
+	}
+
+	hook, _ := failUpdateRequests(2, 3)
+	server.SetFailureHook(hook)
+	partialData, diags := r.update(t, state, withID(testMetaobjectDefinitionModel("After", "number_integer", true), &data))
+	if !hasDiagnostic(diags, diag.SeverityError, "Rollback Failed") {
+		t.Errorf("Update() diagnostics = %v, want the rollback failure", diags)
+	}
+	// The state is left as saved after the first request.
+	assertFieldDefinitionTypes(t, "saved", fieldDefinitionTypes(partialData.FieldDefinitions), map[string]string{"title": "single_line_text_field"})
+}
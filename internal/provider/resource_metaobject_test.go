@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMetaobjectResource(t *testing.T) {
+	metaobjectType := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMetaobjectResourceConfig(metaobjectType),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject.test", "type", metaobjectType),
+					resource.TestCheckResourceAttr("shopify_metaobject.test", "handle", "test"),
+					resource.TestCheckResourceAttr("shopify_metaobject.test", "fields.%", "2"),
+					resource.TestCheckResourceAttr("shopify_metaobject.test", "fields.name", "Test"),
+					resource.TestCheckResourceAttr("shopify_metaobject.test", "fields.address", "Tokyo"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_metaobject.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccMetaobjectResourceUpdateConfig(metaobjectType),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject.test", "handle", "updated-test"),
+					resource.TestCheckResourceAttr("shopify_metaobject.test", "fields.%", "1"),
+					resource.TestCheckResourceAttr("shopify_metaobject.test", "fields.name", "Updated Test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaobjectResource_handleTaken(t *testing.T) {
+	metaobjectType := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The existing metaobject of the handle isn't taken over
+			{
+				Config:      testAccMetaobjectResourceHandleTakenConfig(metaobjectType),
+				ExpectError: regexp.MustCompile(`code: TAKEN`),
+			},
+		},
+	})
+}
+
+func testAccMetaobjectResourceDefinitionConfig(metaobjectType string) string {
+	return fmt.Sprintf(`
+resource "shopify_metaobject_definition" "test" {
+  name = "Test"
+  type = %[1]q
+  field_definitions = [
+    {
+      key      = "name"
+      name     = "Name"
+      type     = "single_line_text_field"
+      required = true
+    },
+    {
+      key  = "address"
+      name = "Address"
+      type = "single_line_text_field"
+    }
+  ]
+}
+`, metaobjectType)
+}
+
+func testAccMetaobjectResourceConfig(metaobjectType string) string {
+	return testAccMetaobjectResourceDefinitionConfig(metaobjectType) + `
+resource "shopify_metaobject" "test" {
+  type   = shopify_metaobject_definition.test.type
+  handle = "test"
+  fields = {
+    name    = "Test"
+    address = "Tokyo"
+  }
+}
+`
+}
+
+func testAccMetaobjectResourceUpdateConfig(metaobjectType string) string {
+	return testAccMetaobjectResourceDefinitionConfig(metaobjectType) + `
+resource "shopify_metaobject" "test" {
+  type   = shopify_metaobject_definition.test.type
+  handle = "updated-test"
+  fields = {
+    name = "Updated Test"
+  }
+}
+`
+}
+
+func testAccMetaobjectResourceHandleTakenConfig(metaobjectType string) string {
+	return testAccMetaobjectResourceConfig(metaobjectType) + `
+resource "shopify_metaobject" "duplicate" {
+  type   = shopify_metaobject_definition.test.type
+  handle = shopify_metaobject.test.handle
+  fields = {
+    name = "Duplicate"
+  }
+}
+`
+}
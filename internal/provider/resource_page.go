@@ -2,7 +2,10 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 
@@ -16,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
 	"github.com/k-yomo/terraform-provider-shopify/internal/utils"
 )
@@ -140,7 +144,14 @@ func (r *PageResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to parse ID", err.Error()))
 		return
 	}
-	page, err := r.client.Page().Get(ctx, id, nil)
+	page, err := r.client.GetPage(ctx, id)
+	if errors.Is(err, shopify.ErrNotFound) {
+		tflog.Warn(ctx, "page not found, removing from state", map[string]interface{}{
+			"id": data.ID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to get page", err.Error()))
 		return
@@ -197,8 +208,27 @@ func (r *PageResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+// ImportState imports the page by either its ID or `handle:{handle}`.
 func (r *PageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	handle, ok := strings.CutPrefix(req.ID, "handle:")
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	page, err := r.client.FindPageByHandle(ctx, handle)
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to get page", err.Error()))
+		return
+	}
+	if page == nil {
+		resp.Diagnostics.AddError(
+			"Page Not Found",
+			fmt.Sprintf("No page found for handle %q.", handle),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.FormatUint(page.Id, 10))...)
 }
 
 func convertPageToResourceModel(page *goshopify.Page) *PageResourceModel {
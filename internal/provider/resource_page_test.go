@@ -10,7 +10,7 @@ import (
 func TestAccPageResource(t *testing.T) {
 	pageHandle := randResourceID(64)
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { testAccPreCheckAllowFake(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// Create and Read testing
@@ -30,6 +30,12 @@ func TestAccPageResource(t *testing.T) {
 				ResourceName: "shopify_page.test",
 				ImportState:  true,
 			},
+			// ImportState testing by handle
+			{
+				ResourceName:  "shopify_page.test",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("handle:%s", pageHandle),
+			},
 			//// Update and Read testing
 			{
 				Config: testAccPageResourceUpdateConfig(pageHandle),
@@ -0,0 +1,482 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+	"github.com/k-yomo/terraform-provider-shopify/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProductResource{}
+var _ resource.ResourceWithImportState = &ProductResource{}
+
+// Shopify creates a "Title" option with a single "Default Title" value for products without options.
+const (
+	defaultProductOptionName  = "Title"
+	defaultProductOptionValue = "Default Title"
+)
+
+// ProductResource defines the resource implementation.
+type ProductResource struct {
+	client *shopify.Client
+}
+
+func NewProductResource() resource.Resource {
+	return &ProductResource{}
+}
+
+// ProductResourceModel describes the resource data model.
+type ProductResourceModel struct {
+	ID          types.String           `tfsdk:"id"`
+	Title       types.String           `tfsdk:"title"`
+	Handle      types.String           `tfsdk:"handle"`
+	Status      types.String           `tfsdk:"status"`
+	Vendor      types.String           `tfsdk:"vendor"`
+	ProductType types.String           `tfsdk:"product_type"`
+	Tags        []types.String         `tfsdk:"tags"`
+	Options     []*ProductOptionModel  `tfsdk:"options"`
+	Variants    []*ProductVariantModel `tfsdk:"variants"`
+}
+
+// ProductOptionModel describes the product option data model.
+type ProductOptionModel struct {
+	Name   types.String   `tfsdk:"name"`
+	Values []types.String `tfsdk:"values"`
+}
+
+// ProductVariantModel describes the product variant data model.
+type ProductVariantModel struct {
+	ID             types.String            `tfsdk:"id"`
+	OptionValues   map[string]types.String `tfsdk:"option_values"`
+	Price          types.String            `tfsdk:"price"`
+	CompareAtPrice types.String            `tfsdk:"compare_at_price"`
+	SKU            types.String            `tfsdk:"sku"`
+	Barcode        types.String            `tfsdk:"barcode"`
+	Taxable        types.Bool              `tfsdk:"taxable"`
+}
+
+func (r *ProductResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product"
+}
+
+func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Products are the goods that a merchant offers for sale, including their options and variants. Requires API version `2024-04` or later.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique ID of the product.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the product.",
+				Required:            true,
+			},
+			"handle": schema.StringAttribute{
+				MarkdownDescription: "A unique, human-friendly string for the product. Automatically generated from the product's title if not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: `The product status, which controls visibility across all sales channels.
+Possible values are:
+  - ACTIVE
+  - ARCHIVED
+  - DRAFT
+`,
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("ACTIVE"),
+			},
+			"vendor": schema.StringAttribute{
+				MarkdownDescription: "The name of the product's vendor.",
+				Optional:            true,
+			},
+			"product_type": schema.StringAttribute{
+				MarkdownDescription: "The product type that merchants define.",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "A set of searchable keywords that are associated with the product.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"options": schema.ListNestedAttribute{
+				MarkdownDescription: "The product options, e.g. `Size` or `Color`. Must be set together with `variants`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the product option.",
+							Required:            true,
+						},
+						"values": schema.ListAttribute{
+							MarkdownDescription: "The values of the product option.",
+							ElementType:         types.StringType,
+							Required:            true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"variants": schema.ListNestedAttribute{
+				MarkdownDescription: "The product variants. Each variant is identified by its combination of option values. If not set, Shopify manages a single default variant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique ID of the product variant.",
+							Computed:            true,
+						},
+						"option_values": schema.MapAttribute{
+							MarkdownDescription: "The option values of the variant, keyed by option name.",
+							ElementType:         types.StringType,
+							Required:            true,
+						},
+						"price": schema.StringAttribute{
+							MarkdownDescription: "The price of the variant, formatted with two decimals, e.g. `19.99`.",
+							Optional:            true,
+							Computed:            true,
+						},
+						"compare_at_price": schema.StringAttribute{
+							MarkdownDescription: "The compare-at price of the variant, formatted with two decimals, e.g. `24.99`.",
+							Optional:            true,
+						},
+						"sku": schema.StringAttribute{
+							MarkdownDescription: "A case-sensitive identifier for the variant in the shop.",
+							Optional:            true,
+						},
+						"barcode": schema.StringAttribute{
+							MarkdownDescription: "The value of the barcode associated with the variant.",
+							Optional:            true,
+						},
+						"taxable": schema.BoolAttribute{
+							MarkdownDescription: "Whether a tax is charged when the variant is sold.",
+							Optional:            true,
+							Computed:            true,
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *ProductResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *ProductResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProductResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := convertProductModelToSetInput(&data, nil)
+	createdProduct, err := r.client.CreateProduct(ctx, input)
+	if err != nil {
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "create product", err, nil)
+		return
+	}
+
+	createdData := convertProductToResourceModel(createdProduct, &data)
+	tflog.Trace(ctx, "created a product", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *ProductResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProductResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	product, err := r.client.GetProduct(ctx, data.ID.ValueString())
+	if errors.Is(err, shopify.ErrNotFound) {
+		tflog.Warn(ctx, "product not found, removing from state", map[string]interface{}{
+			"id": data.ID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read product, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertProductToResourceModel(product, &data))...)
+}
+
+func (r *ProductResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProductResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Variant IDs are unknown in the plan when anything changes,
+	// so existing variants are looked up from the state by their option values.
+	var oldVariants []*ProductVariantModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("variants"), &oldVariants)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	variantIDMap := make(map[string]string, len(oldVariants))
+	for _, variant := range oldVariants {
+		variantIDMap[productVariantKey(variant.OptionValues)] = variant.ID.ValueString()
+	}
+
+	input := convertProductModelToSetInput(&data, variantIDMap)
+	input.ID = data.ID.ValueStringPointer()
+	updatedProduct, err := r.client.UpdateProduct(ctx, input)
+	if err != nil {
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "update product", err, nil)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertProductToResourceModel(updatedProduct, &data))...)
+}
+
+func (r *ProductResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProductResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteProduct(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete product, got error: %s", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted a product", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *ProductResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func convertProductModelToSetInput(data *ProductResourceModel, variantIDMap map[string]string) *shopify.ProductSetInput {
+	tags := make([]string, 0, len(data.Tags))
+	for _, tag := range data.Tags {
+		tags = append(tags, tag.ValueString())
+	}
+
+	// Empty strings are sent for null values to clear the vendor and the product type removed from the config.
+	input := &shopify.ProductSetInput{
+		Title:       data.Title.ValueString(),
+		Status:      data.Status.ValueString(),
+		Vendor:      utils.Ptr(data.Vendor.ValueString()),
+		ProductType: utils.Ptr(data.ProductType.ValueString()),
+		Tags:        tags,
+	}
+	if data.Handle.ValueString() != "" {
+		input.Handle = data.Handle.ValueStringPointer()
+	}
+
+	for i, option := range data.Options {
+		values := make([]*shopify.OptionValueSetInput, 0, len(option.Values))
+		for _, value := range option.Values {
+			values = append(values, &shopify.OptionValueSetInput{Name: value.ValueString()})
+		}
+		input.ProductOptions = append(input.ProductOptions, &shopify.OptionSetInput{
+			Name:     option.Name.ValueString(),
+			Position: i + 1,
+			Values:   values,
+		})
+	}
+
+	for _, variant := range data.Variants {
+		optionNames := make([]string, 0, len(variant.OptionValues))
+		for name := range variant.OptionValues {
+			optionNames = append(optionNames, name)
+		}
+		sort.Strings(optionNames)
+		optionValues := make([]*shopify.VariantOptionValueInput, 0, len(optionNames))
+		for _, name := range optionNames {
+			optionValues = append(optionValues, &shopify.VariantOptionValueInput{
+				OptionName: name,
+				Name:       variant.OptionValues[name].ValueString(),
+			})
+		}
+
+		variantInput := &shopify.ProductVariantSetInput{
+			OptionValues:   optionValues,
+			CompareAtPrice: variant.CompareAtPrice.ValueStringPointer(),
+			SKU:            variant.SKU.ValueStringPointer(),
+			Barcode:        variant.Barcode.ValueStringPointer(),
+		}
+		if id, ok := variantIDMap[productVariantKey(variant.OptionValues)]; ok {
+			variantInput.ID = &id
+		}
+		if !variant.Price.IsUnknown() {
+			variantInput.Price = variant.Price.ValueStringPointer()
+		}
+		if !variant.Taxable.IsUnknown() {
+			variantInput.Taxable = variant.Taxable.ValueBoolPointer()
+		}
+		input.Variants = append(input.Variants, variantInput)
+	}
+
+	return input
+}
+
+func convertProductToResourceModel(product *shopify.Product, data *ProductResourceModel) *ProductResourceModel {
+	// Shopify API handles empty string and null as the same value
+	// So not to produce inconsistency after apply, we'll set the same value as the plan if it's empty
+	vendor := types.StringValue(product.Vendor)
+	if product.Vendor == "" && data.Vendor.IsNull() {
+		vendor = types.StringNull()
+	}
+	productType := types.StringValue(product.ProductType)
+	if product.ProductType == "" && data.ProductType.IsNull() {
+		productType = types.StringNull()
+	}
+
+	var tags []types.String
+	if len(product.Tags) > 0 || data.Tags != nil {
+		tags = make([]types.String, 0, len(product.Tags))
+	}
+	for _, tag := range product.Tags {
+		tags = append(tags, types.StringValue(tag))
+	}
+
+	var variants []*shopify.ProductVariant
+	if product.Variants != nil {
+		variants = product.Variants.Nodes
+	}
+
+	return &ProductResourceModel{
+		ID:          types.StringValue(product.ID),
+		Title:       types.StringValue(product.Title),
+		Handle:      types.StringValue(product.Handle),
+		Status:      types.StringValue(product.Status),
+		Vendor:      vendor,
+		ProductType: productType,
+		Tags:        tags,
+		Options:     convertProductOptionsToModels(product.Options, data.Options),
+		Variants:    convertProductVariantsToModels(variants, data.Variants),
+	}
+}
+
+func convertProductOptionsToModels(options []*shopify.ProductOption, optionModels []*ProductOptionModel) []*ProductOptionModel {
+	if optionModels == nil && isDefaultProductOptions(options) {
+		return nil
+	}
+	models := make([]*ProductOptionModel, 0, len(options))
+	for _, option := range options {
+		values := make([]types.String, 0, len(option.OptionValues))
+		for _, value := range option.OptionValues {
+			values = append(values, types.StringValue(value.Name))
+		}
+		models = append(models, &ProductOptionModel{
+			Name:   types.StringValue(option.Name),
+			Values: values,
+		})
+	}
+	return models
+}
+
+func convertProductVariantsToModels(variants []*shopify.ProductVariant, variantModels []*ProductVariantModel) []*ProductVariantModel {
+	if variantModels == nil && len(variants) == 1 && isDefaultProductVariant(variants[0]) {
+		return nil
+	}
+
+	variantModelMap := make(map[string]*ProductVariantModel, len(variantModels))
+	variantOrderMap := make(map[string]int, len(variantModels))
+	for i, model := range variantModels {
+		key := productVariantKey(model.OptionValues)
+		variantModelMap[key] = model
+		variantOrderMap[key] = i
+	}
+
+	models := make([]*ProductVariantModel, 0, len(variants))
+	for _, variant := range variants {
+		optionValues := make(map[string]types.String, len(variant.SelectedOptions))
+		for _, selectedOption := range variant.SelectedOptions {
+			optionValues[selectedOption.Name] = types.StringValue(selectedOption.Value)
+		}
+		model := &ProductVariantModel{
+			ID:             types.StringValue(variant.ID),
+			OptionValues:   optionValues,
+			Price:          types.StringValue(variant.Price),
+			CompareAtPrice: types.StringPointerValue(variant.CompareAtPrice),
+			SKU:            types.StringValue(variant.SKU),
+			Barcode:        types.StringPointerValue(variant.Barcode),
+			Taxable:        types.BoolValue(variant.Taxable),
+		}
+		// Shopify API handles empty string and null as the same value
+		oldModel, ok := variantModelMap[productVariantKey(optionValues)]
+		if variant.SKU == "" && (!ok || oldModel.SKU.IsNull()) {
+			model.SKU = types.StringNull()
+		}
+		if variant.Barcode != nil && *variant.Barcode == "" && (!ok || oldModel.Barcode.IsNull()) {
+			model.Barcode = types.StringNull()
+		}
+		models = append(models, model)
+	}
+
+	// Sort variants by order in the original data not to produce unnecessary diffs
+	sort.SliceStable(models, func(i, j int) bool {
+		iOrder, ok := variantOrderMap[productVariantKey(models[i].OptionValues)]
+		if !ok {
+			iOrder = len(variantModels)
+		}
+		jOrder, ok := variantOrderMap[productVariantKey(models[j].OptionValues)]
+		if !ok {
+			jOrder = len(variantModels)
+		}
+		return iOrder < jOrder
+	})
+	return models
+}
+
+func isDefaultProductOptions(options []*shopify.ProductOption) bool {
+	return len(options) == 1 &&
+		options[0].Name == defaultProductOptionName &&
+		len(options[0].OptionValues) == 1 &&
+		options[0].OptionValues[0].Name == defaultProductOptionValue
+}
+
+func isDefaultProductVariant(variant *shopify.ProductVariant) bool {
+	return len(variant.SelectedOptions) == 1 &&
+		variant.SelectedOptions[0].Name == defaultProductOptionName &&
+		variant.SelectedOptions[0].Value == defaultProductOptionValue
+}
+
+// productVariantKey returns a key which uniquely identifies a variant within a product.
+func productVariantKey(optionValues map[string]types.String) string {
+	pairs := make([]string, 0, len(optionValues))
+	for name, value := range optionValues {
+		pairs = append(pairs, name+"="+value.ValueString())
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProductResource(t *testing.T) {
+	productHandle := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProductResourceConfig(productHandle),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_product.test", "handle", productHandle),
+					resource.TestCheckResourceAttr("shopify_product.test", "title", "Test product"),
+					resource.TestCheckResourceAttr("shopify_product.test", "status", "DRAFT"),
+					resource.TestCheckResourceAttr("shopify_product.test", "tags.#", "1"),
+					resource.TestCheckNoResourceAttr("shopify_product.test", "options"),
+					resource.TestCheckNoResourceAttr("shopify_product.test", "variants"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_product.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProductResourceUpdateConfig(productHandle),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_product.test", "title", "Updated test product"),
+					resource.TestCheckResourceAttr("shopify_product.test", "vendor", "Terraform"),
+					resource.TestCheckResourceAttr("shopify_product.test", "product_type", "Test"),
+					resource.TestCheckResourceAttr("shopify_product.test", "options.0.name", "Size"),
+					resource.TestCheckResourceAttr("shopify_product.test", "options.0.values.#", "2"),
+					resource.TestCheckResourceAttr("shopify_product.test", "variants.#", "2"),
+					resource.TestCheckResourceAttr("shopify_product.test", "variants.0.option_values.Size", "S"),
+					resource.TestCheckResourceAttr("shopify_product.test", "variants.0.price", "10.00"),
+					resource.TestCheckResourceAttr("shopify_product.test", "variants.1.sku", "TEST-M"),
+				),
+			},
+			// Remove the vendor and the product type
+			{
+				Config: testAccProductResourceConfig(productHandle),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_product.test", "title", "Test product"),
+					resource.TestCheckNoResourceAttr("shopify_product.test", "vendor"),
+					resource.TestCheckNoResourceAttr("shopify_product.test", "product_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProductResourceConfig(productHandle string) string {
+	return fmt.Sprintf(`
+resource "shopify_product" "test" {
+  handle = %[1]q
+  title  = "Test product"
+  status = "DRAFT"
+  tags   = ["terraform"]
+}
+`, productHandle)
+}
+
+func testAccProductResourceUpdateConfig(productHandle string) string {
+	return fmt.Sprintf(`
+resource "shopify_product" "test" {
+  handle       = %[1]q
+  title        = "Updated test product"
+  status       = "DRAFT"
+  vendor       = "Terraform"
+  product_type = "Test"
+  tags         = ["terraform"]
+  options = [
+    {
+      name   = "Size"
+      values = ["S", "M"]
+    }
+  ]
+  variants = [
+    {
+      option_values = { Size = "S" }
+      price         = "10.00"
+      sku           = "TEST-S"
+    },
+    {
+      option_values = { Size = "M" }
+      price         = "12.00"
+      sku           = "TEST-M"
+    }
+  ]
+}
+`, productHandle)
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+)
+
+// The Read tests call the resources directly against the fake server, since the objects are deleted
+// outside Terraform between the apply and the refresh.
+
+func TestResource_Read_deleted(t *testing.T) {
+	tests := []struct {
+		name string
+		// setup creates the object and returns the resource, the state of the object and the function deleting it.
+		setup func(t *testing.T, client *shopify.Client) (resource.Resource, tfsdk.State, func() error)
+	}{
+		{
+			name: "page",
+			setup: func(t *testing.T, client *shopify.Client) (resource.Resource, tfsdk.State, func() error) {
+				ctx := context.Background()
+				page, err := client.Page().Create(ctx, goshopify.Page{Title: "About us"})
+				if err != nil {
+					t.Fatal(err)
+				}
+				r := &PageResource{client: client}
+				state := newTestState(t, r, convertPageToResourceModel(page))
+				return r, state, func() error {
+					return client.Page().Delete(ctx, page.Id)
+				}
+			},
+		},
+		{
+			name: "metaobject definition",
+			setup: func(t *testing.T, client *shopify.Client) (resource.Resource, tfsdk.State, func() error) {
+				r, state := newMetaobjectDefinitionTestResource(t, client, false)
+				var id types.String
+				if diags := state.GetAttribute(context.Background(), path.Root("id"), &id); diags.HasError() {
+					t.Fatal(diags)
+				}
+				return r.resource, state, func() error {
+					return client.DeleteMetaobjectDefinition(context.Background(), id.ValueString())
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, client := newFakeClient(t)
+			r, state, deleteObject := tt.setup(t, client)
+
+			read := func() resource.ReadResponse {
+				resp := resource.ReadResponse{State: state}
+				r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("Read() diagnostics: %v", resp.Diagnostics)
+				}
+				return resp
+			}
+			if resp := read(); resp.State.Raw.IsNull() {
+				t.Fatal("Read() removed the existing object from the state")
+			}
+
+			if err := deleteObject(); err != nil {
+				t.Fatal(err)
+			}
+			if resp := read(); !resp.State.Raw.IsNull() {
+				t.Errorf("Read() kept the deleted object in the state: %v", resp.State.Raw)
+			}
+		})
+	}
+}
+
+// newTestState returns the state of the resource set to the model.
+func newTestState(t *testing.T, r resource.Resource, model interface{}) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("Set() diagnostics: %v", diags)
+	}
+	return state
+}
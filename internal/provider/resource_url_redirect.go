@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &URLRedirectResource{}
+var _ resource.ResourceWithImportState = &URLRedirectResource{}
+
+// URLRedirectResource defines the resource implementation.
+type URLRedirectResource struct {
+	client *shopify.Client
+}
+
+func NewURLRedirectResource() resource.Resource {
+	return &URLRedirectResource{}
+}
+
+// URLRedirectResourceModel describes the resource data model.
+type URLRedirectResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Path   types.String `tfsdk:"path"`
+	Target types.String `tfsdk:"target"`
+}
+
+func (r *URLRedirectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_url_redirect"
+}
+
+func (r *URLRedirectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The URL redirect can be used to set up redirects for the online store, e.g. `/products/old-product` to `/products/new-product`. Use `shopify_url_redirects` to manage a large number of redirects as a unit.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique ID of the URL redirect.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The old path to be redirected from. When the user visits this path, they will be redirected to the target location.",
+				Required:            true,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "The target location where the user will be redirected to.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *URLRedirectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *URLRedirectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data URLRedirectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdURLRedirect, err := r.client.CreateURLRedirect(ctx, &shopify.URLRedirectInput{
+		Path:   data.Path.ValueString(),
+		Target: data.Target.ValueString(),
+	})
+	if err != nil {
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "create URL redirect", err, nil)
+		return
+	}
+
+	createdData := convertURLRedirectToResourceModel(createdURLRedirect)
+	tflog.Trace(ctx, "created a URL redirect", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *URLRedirectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data URLRedirectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	urlRedirect, err := r.client.GetURLRedirect(ctx, data.ID.ValueString())
+	if errors.Is(err, shopify.ErrNotFound) {
+		tflog.Warn(ctx, "URL redirect not found, removing from state", map[string]interface{}{
+			"id": data.ID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read URL redirect, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertURLRedirectToResourceModel(urlRedirect))...)
+}
+
+func (r *URLRedirectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data URLRedirectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedURLRedirect, err := r.client.UpdateURLRedirect(ctx, data.ID.ValueString(), &shopify.URLRedirectInput{
+		Path:   data.Path.ValueString(),
+		Target: data.Target.ValueString(),
+	})
+	if err != nil {
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "update URL redirect", err, nil)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertURLRedirectToResourceModel(updatedURLRedirect))...)
+}
+
+func (r *URLRedirectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data URLRedirectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteURLRedirect(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete URL redirect, got error: %s", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted a URL redirect", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *URLRedirectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func convertURLRedirectToResourceModel(urlRedirect *shopify.URLRedirect) *URLRedirectResourceModel {
+	return &URLRedirectResourceModel{
+		ID:     types.StringValue(urlRedirect.ID),
+		Path:   types.StringValue(urlRedirect.Path),
+		Target: types.StringValue(urlRedirect.Target),
+	}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccURLRedirectResource(t *testing.T) {
+	redirectPath := "/" + randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccURLRedirectResourceConfig(redirectPath, "/pages/test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_url_redirect.test", "path", redirectPath),
+					resource.TestCheckResourceAttr("shopify_url_redirect.test", "target", "/pages/test"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_url_redirect.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccURLRedirectResourceConfig(redirectPath, "/pages/updated-test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_url_redirect.test", "target", "/pages/updated-test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccURLRedirectResourceConfig(redirectPath, target string) string {
+	return fmt.Sprintf(`
+resource "shopify_url_redirect" "test" {
+  path   = %[1]q
+  target = %[2]q
+}
+`, redirectPath, target)
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+	"github.com/rs/xid"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &URLRedirectsResource{}
+
+// URLRedirectsResource defines the resource implementation.
+type URLRedirectsResource struct {
+	client *shopify.Client
+}
+
+func NewURLRedirectsResource() resource.Resource {
+	return &URLRedirectsResource{}
+}
+
+// URLRedirectsResourceModel describes the resource data model.
+type URLRedirectsResourceModel struct {
+	ID        types.String            `tfsdk:"id"`
+	Redirects map[string]types.String `tfsdk:"redirects"`
+}
+
+func (r *URLRedirectsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_url_redirects"
+}
+
+func (r *URLRedirectsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a set of URL redirects as a unit. Only the redirects whose path is in the map are managed; other redirects of the shop are left untouched. Redirects which already exist for a path are adopted instead of failing.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique ID of the URL redirect set. This is generated by the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"redirects": schema.MapAttribute{
+				MarkdownDescription: "The map of old paths to the target locations.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *URLRedirectsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *URLRedirectsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data URLRedirectsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(xid.New().String())
+	data.Redirects = r.syncURLRedirects(ctx, data.Redirects, nil, &resp.Diagnostics)
+	tflog.Trace(ctx, "created a URL redirect set", map[string]interface{}{
+		"id": data.ID,
+	})
+
+	// Save the progress even on failure so that created redirects are tracked.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *URLRedirectsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data URLRedirectsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	liveURLRedirectMap, err := r.getURLRedirectMap(ctx, data.Redirects)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read URL redirects, got error: %s", err))
+		return
+	}
+
+	redirects := make(map[string]types.String, len(data.Redirects))
+	for redirectPath := range data.Redirects {
+		if liveURLRedirect, ok := liveURLRedirectMap[redirectPath]; ok {
+			redirects[redirectPath] = types.StringValue(liveURLRedirect.Target)
+		}
+	}
+	data.Redirects = redirects
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *URLRedirectsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data URLRedirectsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state URLRedirectsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Redirects = r.syncURLRedirects(ctx, data.Redirects, state.Redirects, &resp.Diagnostics)
+
+	// Save the progress even on failure so that the next apply only retries the failed redirects.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *URLRedirectsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data URLRedirectsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remaining := r.syncURLRedirects(ctx, nil, data.Redirects, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		// Keep the redirects which failed to be deleted in the state.
+		data.Redirects = remaining
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+	tflog.Trace(ctx, "deleted a URL redirect set", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+// getURLRedirectMap returns the live URL redirects of the paths in the redirect maps keyed by path.
+// The redirects are searched by path, since shops may have far more redirects than the managed ones.
+func (r *URLRedirectsResource) getURLRedirectMap(ctx context.Context, redirectMaps ...map[string]types.String) (map[string]*shopify.URLRedirect, error) {
+	var paths []string
+	seen := map[string]bool{}
+	for _, redirects := range redirectMaps {
+		for redirectPath := range redirects {
+			if !seen[redirectPath] {
+				seen[redirectPath] = true
+				paths = append(paths, redirectPath)
+			}
+		}
+	}
+	// Sort the paths to send the same queries on every refresh.
+	sort.Strings(paths)
+	return r.client.ListURLRedirectsByPaths(ctx, paths)
+}
+
+// syncURLRedirects diffs the desired redirects against the live ones and issues only the necessary
+// create, update and delete calls. Live redirects whose path is in oldRedirects but not in desired are deleted.
+// The redirects which are actually applied are returned, so that partial progress can be saved on failure.
+func (r *URLRedirectsResource) syncURLRedirects(ctx context.Context, desired, oldRedirects map[string]types.String, diags *diag.Diagnostics) map[string]types.String {
+	applied := make(map[string]types.String, len(desired))
+
+	liveURLRedirectMap, err := r.getURLRedirectMap(ctx, desired, oldRedirects)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read URL redirects, got error: %s", err))
+		return oldRedirects
+	}
+
+	for redirectPath, oldTarget := range oldRedirects {
+		if _, ok := desired[redirectPath]; ok {
+			continue
+		}
+		liveURLRedirect, ok := liveURLRedirectMap[redirectPath]
+		if !ok {
+			continue
+		}
+		if err := r.client.DeleteURLRedirect(ctx, liveURLRedirect.ID); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete URL redirect for %s, got error: %s", redirectPath, err))
+			applied[redirectPath] = oldTarget
+		}
+	}
+
+	for redirectPath, target := range desired {
+		input := &shopify.URLRedirectInput{Path: redirectPath, Target: target.ValueString()}
+		liveURLRedirect, ok := liveURLRedirectMap[redirectPath]
+		switch {
+		case !ok:
+			if _, err := r.client.CreateURLRedirect(ctx, input); err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to create URL redirect for %s, got error: %s", redirectPath, err))
+				continue
+			}
+		case liveURLRedirect.Target != input.Target:
+			if _, err := r.client.UpdateURLRedirect(ctx, liveURLRedirect.ID, input); err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to update URL redirect for %s, got error: %s", redirectPath, err))
+				applied[redirectPath] = types.StringValue(liveURLRedirect.Target)
+				continue
+			}
+		}
+		applied[redirectPath] = target
+	}
+
+	return applied
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccURLRedirectsResource(t *testing.T) {
+	pathPrefix := "/" + randResourceID(32)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccURLRedirectsResourceConfig(pathPrefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_url_redirects.test", "redirects.%", "2"),
+					resource.TestCheckResourceAttr("shopify_url_redirects.test", "redirects."+pathPrefix+"/a", "/pages/a"),
+					resource.TestCheckResourceAttr("shopify_url_redirects.test", "redirects."+pathPrefix+"/b", "/pages/b"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccURLRedirectsResourceUpdateConfig(pathPrefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_url_redirects.test", "redirects.%", "2"),
+					resource.TestCheckResourceAttr("shopify_url_redirects.test", "redirects."+pathPrefix+"/b", "/pages/updated-b"),
+					resource.TestCheckResourceAttr("shopify_url_redirects.test", "redirects."+pathPrefix+"/c", "/pages/c"),
+				),
+			},
+		},
+	})
+}
+
+func testAccURLRedirectsResourceConfig(pathPrefix string) string {
+	return fmt.Sprintf(`
+resource "shopify_url_redirects" "test" {
+  redirects = {
+    "%[1]s/a" = "/pages/a"
+    "%[1]s/b" = "/pages/b"
+  }
+}
+`, pathPrefix)
+}
+
+func testAccURLRedirectsResourceUpdateConfig(pathPrefix string) string {
+	return fmt.Sprintf(`
+resource "shopify_url_redirects" "test" {
+  redirects = {
+    "%[1]s/b" = "/pages/updated-b"
+    "%[1]s/c" = "/pages/c"
+  }
+}
+`, pathPrefix)
+}
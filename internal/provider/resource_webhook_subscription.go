@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+	"github.com/k-yomo/terraform-provider-shopify/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WebhookSubscriptionResource{}
+var _ resource.ResourceWithImportState = &WebhookSubscriptionResource{}
+var _ resource.ResourceWithValidateConfig = &WebhookSubscriptionResource{}
+var _ resource.ResourceWithModifyPlan = &WebhookSubscriptionResource{}
+
+// WebhookSubscriptionResource defines the resource implementation.
+type WebhookSubscriptionResource struct {
+	client *shopify.Client
+}
+
+func NewWebhookSubscriptionResource() resource.Resource {
+	return &WebhookSubscriptionResource{}
+}
+
+// WebhookSubscriptionResourceModel describes the resource data model.
+type WebhookSubscriptionResourceModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	Topic               types.String   `tfsdk:"topic"`
+	CallbackURL         types.String   `tfsdk:"callback_url"`
+	EventBridgeARN      types.String   `tfsdk:"event_bridge_arn"`
+	PubSubProject       types.String   `tfsdk:"pub_sub_project"`
+	PubSubTopic         types.String   `tfsdk:"pub_sub_topic"`
+	Format              types.String   `tfsdk:"format"`
+	IncludeFields       []types.String `tfsdk:"include_fields"`
+	MetafieldNamespaces []types.String `tfsdk:"metafield_namespaces"`
+	Filter              types.String   `tfsdk:"filter"`
+}
+
+// endpointType returns the kind of the configured endpoint.
+func (m *WebhookSubscriptionResourceModel) endpointType() string {
+	switch {
+	case !m.EventBridgeARN.IsNull():
+		return "event_bridge"
+	case !m.PubSubProject.IsNull() || !m.PubSubTopic.IsNull():
+		return "pub_sub"
+	default:
+		return "http"
+	}
+}
+
+func (r *WebhookSubscriptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_subscription"
+}
+
+func (r *WebhookSubscriptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A webhook subscription is a persisted data object created by an app using the REST Admin API or GraphQL Admin API. It describes the topic that the app wants to receive, and a destination where Shopify should send webhooks of the specified topic. Exactly one of `callback_url`, `event_bridge_arn` or `pub_sub_project` and `pub_sub_topic` must be set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique ID of the webhook subscription.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"topic": schema.StringAttribute{
+				MarkdownDescription: "The type of event that triggers the webhook, e.g. `ORDERS_CREATE`. Refer to the list of [supported topics](https://shopify.dev/docs/api/admin-graphql/latest/enums/WebhookSubscriptionTopic).",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"callback_url": schema.StringAttribute{
+				MarkdownDescription: "The URL where the webhook subscription should send the POST request when the event occurs.",
+				Optional:            true,
+			},
+			"event_bridge_arn": schema.StringAttribute{
+				MarkdownDescription: "The ARN of the Amazon EventBridge partner event source.",
+				Optional:            true,
+			},
+			"pub_sub_project": schema.StringAttribute{
+				MarkdownDescription: "The Google Cloud Pub/Sub project ID.",
+				Optional:            true,
+			},
+			"pub_sub_topic": schema.StringAttribute{
+				MarkdownDescription: "The Google Cloud Pub/Sub topic ID.",
+				Optional:            true,
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "The format in which the webhook subscription should send the data. Possible values are `JSON` and `XML`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("JSON"),
+			},
+			"include_fields": schema.ListAttribute{
+				MarkdownDescription: "The list of fields to be included in the webhook subscription.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"metafield_namespaces": schema.ListAttribute{
+				MarkdownDescription: "The list of namespaces for any metafields that should be included in the webhook subscription.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "A constraint specified using search syntax that ensures only webhooks that match the specified filter are emitted.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *WebhookSubscriptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *WebhookSubscriptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data WebhookSubscriptionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpointCount := 0
+	if !data.CallbackURL.IsNull() {
+		endpointCount++
+	}
+	if !data.EventBridgeARN.IsNull() {
+		endpointCount++
+	}
+	if !data.PubSubProject.IsNull() || !data.PubSubTopic.IsNull() {
+		endpointCount++
+		if data.PubSubProject.IsNull() || data.PubSubTopic.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("pub_sub_topic"),
+				"Incomplete Pub/Sub endpoint",
+				"pub_sub_project and pub_sub_topic must be set together.",
+			)
+		}
+	}
+	if endpointCount != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid webhook endpoint",
+			"Exactly one of callback_url, event_bridge_arn or pub_sub_project and pub_sub_topic must be set.",
+		)
+	}
+}
+
+// ModifyPlan requires replacement when the endpoint type is changed since Shopify can't convert it.
+func (r *WebhookSubscriptionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Do not replace on resource creation or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state WebhookSubscriptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.endpointType() != state.endpointType() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("callback_url"), path.Root("event_bridge_arn"), path.Root("pub_sub_project"))
+	}
+}
+
+func (r *WebhookSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WebhookSubscriptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdWebhookSubscription, err := r.client.CreateWebhookSubscription(ctx, data.Topic.ValueString(), convertWebhookSubscriptionModelToInput(&data))
+	if err != nil {
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "create webhook subscription", err, nil)
+		return
+	}
+
+	createdData := convertWebhookSubscriptionToResourceModel(createdWebhookSubscription, &data)
+	tflog.Trace(ctx, "created a webhook subscription", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *WebhookSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WebhookSubscriptionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookSubscription, err := r.client.GetWebhookSubscription(ctx, data.ID.ValueString())
+	if errors.Is(err, shopify.ErrNotFound) {
+		tflog.Warn(ctx, "webhook subscription not found, removing from state", map[string]interface{}{
+			"id": data.ID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webhook subscription, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertWebhookSubscriptionToResourceModel(webhookSubscription, &data))...)
+}
+
+func (r *WebhookSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WebhookSubscriptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedWebhookSubscription, err := r.client.UpdateWebhookSubscription(ctx, data.ID.ValueString(), convertWebhookSubscriptionModelToInput(&data))
+	if err != nil {
+		appendClientError(ctx, &resp.Diagnostics, req.Plan, "update webhook subscription", err, nil)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertWebhookSubscriptionToResourceModel(updatedWebhookSubscription, &data))...)
+}
+
+func (r *WebhookSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WebhookSubscriptionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteWebhookSubscription(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete webhook subscription, got error: %s", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted a webhook subscription", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *WebhookSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func convertWebhookSubscriptionModelToInput(data *WebhookSubscriptionResourceModel) *shopify.WebhookSubscriptionInput {
+	includeFields := make([]string, 0, len(data.IncludeFields))
+	for _, field := range data.IncludeFields {
+		includeFields = append(includeFields, field.ValueString())
+	}
+	metafieldNamespaces := make([]string, 0, len(data.MetafieldNamespaces))
+	for _, namespace := range data.MetafieldNamespaces {
+		metafieldNamespaces = append(metafieldNamespaces, namespace.ValueString())
+	}
+	// An empty filter is sent for null to clear the filter removed from the config.
+	return &shopify.WebhookSubscriptionInput{
+		CallbackURL:         data.CallbackURL.ValueString(),
+		ARN:                 data.EventBridgeARN.ValueString(),
+		PubSubProject:       data.PubSubProject.ValueString(),
+		PubSubTopic:         data.PubSubTopic.ValueString(),
+		Format:              data.Format.ValueString(),
+		IncludeFields:       includeFields,
+		MetafieldNamespaces: metafieldNamespaces,
+		Filter:              utils.Ptr(data.Filter.ValueString()),
+	}
+}
+
+func convertWebhookSubscriptionToResourceModel(webhookSubscription *shopify.WebhookSubscription, data *WebhookSubscriptionResourceModel) *WebhookSubscriptionResourceModel {
+	model := &WebhookSubscriptionResourceModel{
+		ID:                  types.StringValue(webhookSubscription.ID),
+		Topic:               types.StringValue(webhookSubscription.Topic),
+		CallbackURL:         types.StringNull(),
+		EventBridgeARN:      types.StringNull(),
+		PubSubProject:       types.StringNull(),
+		PubSubTopic:         types.StringNull(),
+		Format:              types.StringValue(webhookSubscription.Format),
+		IncludeFields:       convertStringsToModels(webhookSubscription.IncludeFields, data.IncludeFields != nil),
+		MetafieldNamespaces: convertStringsToModels(webhookSubscription.MetafieldNamespaces, data.MetafieldNamespaces != nil),
+		Filter:              types.StringPointerValue(webhookSubscription.Filter),
+	}
+	// Shopify API handles empty string and null as the same value
+	if webhookSubscription.Filter != nil && *webhookSubscription.Filter == "" && data.Filter.IsNull() {
+		model.Filter = types.StringNull()
+	}
+
+	if endpoint := webhookSubscription.Endpoint; endpoint != nil {
+		switch endpoint.TypeName {
+		case "WebhookHttpEndpoint":
+			model.CallbackURL = types.StringValue(endpoint.CallbackURL)
+		case "WebhookEventBridgeEndpoint":
+			model.EventBridgeARN = types.StringValue(endpoint.ARN)
+		case "WebhookPubSubEndpoint":
+			model.PubSubProject = types.StringValue(endpoint.PubSubProject)
+			model.PubSubTopic = types.StringValue(endpoint.PubSubTopic)
+		}
+	}
+	return model
+}
+
+// convertStringsToModels converts strings to a list model.
+// An empty list is converted to null unless keepEmpty is true not to produce unnecessary diffs.
+func convertStringsToModels(values []string, keepEmpty bool) []types.String {
+	if len(values) == 0 && !keepEmpty {
+		return nil
+	}
+	models := make([]types.String, 0, len(values))
+	for _, value := range values {
+		models = append(models, types.StringValue(value))
+	}
+	return models
+}
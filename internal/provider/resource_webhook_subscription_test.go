@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWebhookSubscriptionResource(t *testing.T) {
+	callbackPath := randResourceID(32)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWebhookSubscriptionResourceConfig(callbackPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_webhook_subscription.test", "topic", "PRODUCTS_CREATE"),
+					resource.TestCheckResourceAttr("shopify_webhook_subscription.test", "callback_url", "https://example.com/"+callbackPath),
+					resource.TestCheckResourceAttr("shopify_webhook_subscription.test", "format", "JSON"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_webhook_subscription.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccWebhookSubscriptionResourceUpdateConfig(callbackPath, "vendor:Terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_webhook_subscription.test", "callback_url", "https://example.com/updated/"+callbackPath),
+					resource.TestCheckResourceAttr("shopify_webhook_subscription.test", "include_fields.#", "2"),
+					resource.TestCheckResourceAttr("shopify_webhook_subscription.test", "include_fields.0", "id"),
+					resource.TestCheckResourceAttr("shopify_webhook_subscription.test", "metafield_namespaces.0", "custom"),
+					resource.TestCheckResourceAttr("shopify_webhook_subscription.test", "filter", "vendor:Terraform"),
+				),
+			},
+			// Remove the filter
+			{
+				Config: testAccWebhookSubscriptionResourceUpdateConfig(callbackPath, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_webhook_subscription.test", "callback_url", "https://example.com/updated/"+callbackPath),
+					resource.TestCheckNoResourceAttr("shopify_webhook_subscription.test", "filter"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebhookSubscriptionResourceConfig(callbackPath string) string {
+	return fmt.Sprintf(`
+resource "shopify_webhook_subscription" "test" {
+  topic        = "PRODUCTS_CREATE"
+  callback_url = "https://example.com/%[1]s"
+}
+`, callbackPath)
+}
+
+func testAccWebhookSubscriptionResourceUpdateConfig(callbackPath, filter string) string {
+	filterAttr := ""
+	if filter != "" {
+		filterAttr = fmt.Sprintf("filter               = %q", filter)
+	}
+	return fmt.Sprintf(`
+resource "shopify_webhook_subscription" "test" {
+  topic                = "PRODUCTS_CREATE"
+  callback_url         = "https://example.com/updated/%[1]s"
+  include_fields       = ["id", "title"]
+  metafield_namespaces = ["custom"]
+  %[2]s
+}
+`, callbackPath, filterAttr)
+}
@@ -0,0 +1,107 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+// ArticleService is an interface for interacting with the articles endpoints of the REST Admin API,
+// which go-shopify doesn't provide.
+// See https://shopify.dev/docs/api/admin-rest/latest/resources/article
+type ArticleService interface {
+	// Get returns ErrNotFound when the article doesn't exist.
+	Get(ctx context.Context, blogID uint64, articleID uint64) (*Article, error)
+	Create(ctx context.Context, blogID uint64, article Article) (*Article, error)
+	Update(ctx context.Context, blogID uint64, article Article) (*Article, error)
+	Delete(ctx context.Context, blogID uint64, articleID uint64) error
+}
+
+// ArticleServiceOp handles communication with the article related methods of the REST Admin API.
+type ArticleServiceOp struct {
+	client *goshopify.Client
+}
+
+// Article represents a Shopify blog article.
+type Article struct {
+	ID             uint64        `json:"id,omitempty"`
+	BlogID         uint64        `json:"blog_id,omitempty"`
+	Title          string        `json:"title,omitempty"`
+	Handle         string        `json:"handle,omitempty"`
+	Author         string        `json:"author,omitempty"`
+	BodyHTML       string        `json:"body_html,omitempty"`
+	SummaryHTML    *string       `json:"summary_html,omitempty"`
+	Tags           string        `json:"tags"`
+	TemplateSuffix string        `json:"template_suffix"`
+	PublishedAt    *time.Time    `json:"published_at,omitempty"`
+	Image          *ArticleImage `json:"image,omitempty"`
+	// Published can be set when creating or updating an article.
+	// It's not returned in the response
+	Published *bool `json:"published,omitempty"`
+	// RemoveImage removes the image when updating the article, since the image is left unchanged when omitted.
+	RemoveImage bool `json:"-"`
+}
+
+// MarshalJSON sends an empty image to remove the image when RemoveImage is set.
+func (a Article) MarshalJSON() ([]byte, error) {
+	type article Article
+	if !a.RemoveImage {
+		return json.Marshal(article(a))
+	}
+	return json.Marshal(struct {
+		article
+		Image string `json:"image"`
+	}{article: article(a)})
+}
+
+// ArticleImage represents the image associated with an article.
+type ArticleImage struct {
+	Src string `json:"src,omitempty"`
+	Alt string `json:"alt,omitempty"`
+}
+
+// ArticleResource represents the result from the blogs/X/articles/Y.json endpoint.
+type ArticleResource struct {
+	Article *Article `json:"article"`
+}
+
+func (c *Client) Article() ArticleService {
+	return &ArticleServiceOp{client: c.shopifyClient}
+}
+
+// Get a single article
+func (s *ArticleServiceOp) Get(ctx context.Context, blogID uint64, articleID uint64) (*Article, error) {
+	path := fmt.Sprintf("blogs/%d/articles/%d.json", blogID, articleID)
+	resource := new(ArticleResource)
+	err := s.client.Get(ctx, path, resource, nil)
+	if err != nil {
+		return nil, convertRESTNotFoundError(err, "article", articleID)
+	}
+	return resource.Article, nil
+}
+
+// Create a new article
+func (s *ArticleServiceOp) Create(ctx context.Context, blogID uint64, article Article) (*Article, error) {
+	path := fmt.Sprintf("blogs/%d/articles.json", blogID)
+	wrappedData := ArticleResource{Article: &article}
+	resource := new(ArticleResource)
+	err := s.client.Post(ctx, path, wrappedData, resource)
+	return resource.Article, err
+}
+
+// Update an existing article
+func (s *ArticleServiceOp) Update(ctx context.Context, blogID uint64, article Article) (*Article, error) {
+	path := fmt.Sprintf("blogs/%d/articles/%d.json", blogID, article.ID)
+	wrappedData := ArticleResource{Article: &article}
+	resource := new(ArticleResource)
+	err := s.client.Put(ctx, path, wrappedData, resource)
+	return resource.Article, err
+}
+
+// Delete an existing article
+func (s *ArticleServiceOp) Delete(ctx context.Context, blogID uint64, articleID uint64) error {
+	return s.client.Delete(ctx, fmt.Sprintf("blogs/%d/articles/%d.json", blogID, articleID))
+}
@@ -0,0 +1,41 @@
+package shopify
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestArticle_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		article Article
+		want    string
+	}{
+		{
+			name:    "omits the image to leave it unchanged",
+			article: Article{ID: 1, Title: "Title"},
+			want:    `{"id":1,"title":"Title","tags":"","template_suffix":""}`,
+		},
+		{
+			name:    "sends the image",
+			article: Article{ID: 1, Image: &ArticleImage{Src: "https://example.com/image.png", Alt: "Alt"}},
+			want:    `{"id":1,"tags":"","template_suffix":"","image":{"src":"https://example.com/image.png","alt":"Alt"}}`,
+		},
+		{
+			name:    "sends an empty image to remove it",
+			article: Article{ID: 1, Image: &ArticleImage{Src: "https://example.com/image.png"}, RemoveImage: true},
+			want:    `{"id":1,"tags":"","template_suffix":"","image":""}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(ArticleResource{Article: &tt.article})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := `{"article":` + tt.want + `}`; string(got) != want {
+				t.Errorf("json.Marshal() = %s, want %s", got, want)
+			}
+		})
+	}
+}
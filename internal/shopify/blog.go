@@ -0,0 +1,20 @@
+package shopify
+
+import (
+	"context"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+func (c *Client) Blog() goshopify.BlogService {
+	return c.shopifyClient.Blog
+}
+
+// GetBlog gets the blog by its numeric ID. ErrNotFound is returned when the blog doesn't exist.
+func (c *Client) GetBlog(ctx context.Context, id uint64) (*goshopify.Blog, error) {
+	blog, err := c.shopifyClient.Blog.Get(ctx, id, nil)
+	if err != nil {
+		return nil, convertRESTNotFoundError(err, "blog", id)
+	}
+	return blog, nil
+}
@@ -4,12 +4,51 @@ import (
 	goshopify "github.com/bold-commerce/go-shopify/v4"
 )
 
+const defaultMaxRetries = 5
+
 type Client struct {
 	shopifyClient *goshopify.Client
+
+	maxRetries  int
+	concurrency chan struct{}
+	rateLimiter *rateLimiter
+}
+
+// Option is used to configure the client.
+type Option func(c *Client)
+
+// WithMaxRetries sets the maximum number of retries of a throttled or failed GraphQL request.
+func WithMaxRetries(maxRetries int) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+	}
 }
 
-func NewClient(shopifyClient *goshopify.Client) *Client {
-	return &Client{
+// WithMaxConcurrency limits the number of GraphQL requests in flight. 0 means unlimited.
+func WithMaxConcurrency(maxConcurrency int) Option {
+	return func(c *Client) {
+		if maxConcurrency > 0 {
+			c.concurrency = make(chan struct{}, maxConcurrency)
+		} else {
+			c.concurrency = nil
+		}
+	}
+}
+
+func NewClient(shopifyClient *goshopify.Client, opts ...Option) *Client {
+	c := &Client{
 		shopifyClient: shopifyClient,
+		maxRetries:    defaultMaxRetries,
+		rateLimiter:   newRateLimiter(),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// PageInfo is the pagination information of a GraphQL connection.
+type PageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor"`
 }
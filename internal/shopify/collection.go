@@ -0,0 +1,224 @@
+package shopify
+
+import (
+	"context"
+)
+
+type Collection struct {
+	ID              string             `json:"id"`
+	Handle          string             `json:"handle"`
+	Title           string             `json:"title"`
+	DescriptionHTML string             `json:"descriptionHtml"`
+	SortOrder       string             `json:"sortOrder"`
+	TemplateSuffix  *string            `json:"templateSuffix"`
+	Image           *CollectionImage   `json:"image"`
+	SEO             *SEO               `json:"seo"`
+	RuleSet         *CollectionRuleSet `json:"ruleSet"`
+}
+
+type CollectionImage struct {
+	URL     string  `json:"url"`
+	AltText *string `json:"altText"`
+}
+
+type SEO struct {
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+}
+
+type CollectionRuleSet struct {
+	AppliedDisjunctively bool              `json:"appliedDisjunctively"`
+	Rules                []*CollectionRule `json:"rules"`
+}
+
+type CollectionRule struct {
+	Column          string                   `json:"column"`
+	Relation        string                   `json:"relation"`
+	Condition       string                   `json:"condition"`
+	ConditionObject *CollectionRuleCondition `json:"conditionObject"`
+}
+
+// CollectionRuleCondition represents the condition object of the rule.
+// Only CollectionRuleMetafieldCondition is supported.
+type CollectionRuleCondition struct {
+	MetafieldDefinition *struct {
+		ID string `json:"id"`
+	} `json:"metafieldDefinition"`
+}
+
+type CollectionInput struct {
+	ID              *string                 `json:"id,omitempty"`
+	Handle          *string                 `json:"handle,omitempty"`
+	Title           string                  `json:"title"`
+	DescriptionHTML string                  `json:"descriptionHtml"`
+	SortOrder       *string                 `json:"sortOrder,omitempty"`
+	TemplateSuffix  string                  `json:"templateSuffix"`
+	Image           *ImageInput             `json:"image"`
+	SEO             *SEOInput               `json:"seo"`
+	RuleSet         *CollectionRuleSetInput `json:"ruleSet,omitempty"`
+}
+
+type ImageInput struct {
+	Src     string  `json:"src"`
+	AltText *string `json:"altText,omitempty"`
+}
+
+type SEOInput struct {
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+}
+
+type CollectionRuleSetInput struct {
+	AppliedDisjunctively bool                   `json:"appliedDisjunctively"`
+	Rules                []*CollectionRuleInput `json:"rules"`
+}
+
+type CollectionRuleInput struct {
+	Column            string  `json:"column"`
+	Relation          string  `json:"relation"`
+	Condition         string  `json:"condition"`
+	ConditionObjectID *string `json:"conditionObjectId,omitempty"`
+}
+
+const collectionFields = `
+      id
+      handle
+      title
+      descriptionHtml
+      sortOrder
+      templateSuffix
+      image {
+        url
+        altText
+      }
+      seo {
+        title
+        description
+      }
+      ruleSet {
+        appliedDisjunctively
+        rules {
+          column
+          relation
+          condition
+          conditionObject {
+            ... on CollectionRuleMetafieldCondition {
+              metafieldDefinition {
+                id
+              }
+            }
+          }
+        }
+      }
+`
+
+type CollectionMutationResponse struct {
+	Result struct {
+		Collection *Collection `json:"collection"`
+		UserErrors UserErrors  `json:"userErrors"`
+	} `json:"result"`
+}
+
+// CreateCollection creates a collection.
+// The collection is a smart collection if the rule set is given, otherwise it's a custom collection.
+func (c *Client) CreateCollection(ctx context.Context, input *CollectionInput) (*Collection, error) {
+	variables := map[string]interface{}{"input": input}
+	query := `
+mutation CreateCollection($input: CollectionInput!) {
+  result: collectionCreate(input: $input) {
+    collection {` + collectionFields + `    }
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+	var gqlResp CollectionMutationResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.Result.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.Result.Collection, nil
+}
+
+type GetCollectionResponse struct {
+	Collection *Collection `json:"collection"`
+}
+
+func (c *Client) GetCollection(ctx context.Context, id string) (*Collection, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query collection($id: ID!) {
+  collection(id: $id) {` + collectionFields + `  }
+}
+`
+
+	var gqlResp GetCollectionResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if gqlResp.Collection == nil {
+		return nil, newNotFoundError("collection", id)
+	}
+	return gqlResp.Collection, nil
+}
+
+// UpdateCollection updates the collection. The ID of the input must be set.
+func (c *Client) UpdateCollection(ctx context.Context, input *CollectionInput) (*Collection, error) {
+	variables := map[string]interface{}{"input": input}
+	query := `
+mutation UpdateCollection($input: CollectionInput!) {
+  result: collectionUpdate(input: $input) {
+    collection {` + collectionFields + `    }
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+	var gqlResp CollectionMutationResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.Result.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.Result.Collection, nil
+}
+
+func (c *Client) DeleteCollection(ctx context.Context, id string) error {
+	variables := map[string]interface{}{"input": map[string]interface{}{"id": id}}
+	query := `
+mutation DeleteCollection($input: CollectionDeleteInput!) {
+  collectionDelete(input: $input) {
+    deletedCollectionId
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+	type DeleteCollectionResponse struct {
+		CollectionDelete struct {
+			DeletedCollectionID string     `json:"deletedCollectionId"`
+			UserErrors          UserErrors `json:"userErrors"`
+		} `json:"collectionDelete"`
+	}
+	var gqlResp DeleteCollectionResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	if err := gqlResp.CollectionDelete.UserErrors.Error(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -3,13 +3,47 @@ package shopify
 import (
 	"errors"
 	"fmt"
+	"net/http"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
 )
 
+// ErrNotFound is returned by the Get methods when the resource doesn't exist,
+// e.g. it has been deleted in the Shopify admin.
+var ErrNotFound = errors.New("not found")
+
+func newNotFoundError(resourceName string, id interface{}) error {
+	return fmt.Errorf("%s %v: %w", resourceName, id, ErrNotFound)
+}
+
+// convertRESTNotFoundError converts the 404 error of the REST Admin API into ErrNotFound.
+func convertRESTNotFoundError(err error, resourceName string, id interface{}) error {
+	var responseErr goshopify.ResponseError
+	if errors.As(err, &responseErr) && responseErr.Status == http.StatusNotFound {
+		return newNotFoundError(resourceName, id)
+	}
+	return err
+}
+
+// Codes of the user errors that callers may want to handle, e.g. to adopt an existing resource on TAKEN.
+// See the UserErrorCode enums of each mutation for the full list.
+const (
+	UserErrorCodeTaken                = "TAKEN"
+	UserErrorCodeInvalid              = "INVALID"
+	UserErrorCodeBlank                = "BLANK"
+	UserErrorCodeNotFound             = "NOT_FOUND"
+	UserErrorCodeReservedNamespaceKey = "RESERVED_NAMESPACE_KEY"
+)
+
+// UserError is an error returned in `userErrors` of a mutation,
+// e.g. a validation error of the input.
 type UserError struct {
-	Code         *string  `json:"code"`
-	ElementIndex *int     `json:"elementIndex"`
-	Field        []string `json:"field"`
-	Message      string   `json:"message"`
+	Code *string `json:"code"`
+	// ElementIndex is the index of the element in the list argument that caused the error, if any.
+	ElementIndex *int `json:"elementIndex"`
+	// Field is the path to the input field that caused the error, e.g. ["definition", "fieldDefinitions", "0", "key"].
+	Field   []string `json:"field"`
+	Message string   `json:"message"`
 }
 
 func (u *UserError) CodeString() string {
@@ -19,16 +53,46 @@ func (u *UserError) CodeString() string {
 	return *u.Code
 }
 
-func (u *UserError) Error() error {
-	return fmt.Errorf("UserError: code: %s, field: %v, message: %s", u.CodeString(), u.Field, u.Message)
+func (u *UserError) Error() string {
+	return fmt.Sprintf("UserError: code: %s, field: %v, message: %s", u.CodeString(), u.Field, u.Message)
 }
 
 type UserErrors []UserError
 
+// Error returns the user errors joined into an error, or nil if there is no user error.
+// Each of the joined errors is a *UserError, which can be retrieved with AsUserErrors.
 func (u UserErrors) Error() error {
 	errs := make([]error, 0, len(u))
-	for _, userError := range u {
-		errs = append(errs, userError.Error())
+	for i := range u {
+		errs = append(errs, &u[i])
 	}
 	return errors.Join(errs...)
 }
+
+// AsUserErrors returns all the user errors in the tree of the error.
+func AsUserErrors(err error) []*UserError {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case *UserError:
+		return []*UserError{e}
+	case interface{ Unwrap() []error }:
+		var userErrors []*UserError
+		for _, err := range e.Unwrap() {
+			userErrors = append(userErrors, AsUserErrors(err)...)
+		}
+		return userErrors
+	default:
+		return AsUserErrors(errors.Unwrap(err))
+	}
+}
+
+// HasUserErrorCode reports whether the error contains a user error with the code.
+func HasUserErrorCode(err error, code string) bool {
+	for _, userError := range AsUserErrors(err) {
+		if userError.CodeString() == code {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package shopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+func TestConvertRESTNotFoundError(t *testing.T) {
+	otherErr := errors.New("connection reset")
+	serverErr := goshopify.ResponseError{Status: http.StatusInternalServerError, Message: "Internal Server Error"}
+	tests := []struct {
+		name         string
+		err          error
+		wantNotFound bool
+		wantErr      error
+	}{
+		{name: "not found", err: goshopify.ResponseError{Status: http.StatusNotFound, Message: "Not Found"}, wantNotFound: true},
+		{name: "wrapped not found", err: fmt.Errorf("get: %w", goshopify.ResponseError{Status: http.StatusNotFound}), wantNotFound: true},
+		{name: "server error", err: serverErr, wantErr: serverErr},
+		{name: "other error", err: otherErr, wantErr: otherErr},
+		{name: "nil", err: nil, wantErr: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := convertRESTNotFoundError(tt.err, "page", 1)
+			if got := errors.Is(err, ErrNotFound); got != tt.wantNotFound {
+				t.Fatalf("errors.Is(%v, ErrNotFound) = %v, want %v", err, got, tt.wantNotFound)
+			}
+			// The other errors are returned as they are.
+			if !tt.wantNotFound && fmt.Sprint(err) != fmt.Sprint(tt.wantErr) {
+				t.Errorf("convertRESTNotFoundError() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_getNotFound(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		response   string
+		get        func(ctx context.Context, client *Client) error
+	}{
+		{
+			name:       "REST 404",
+			statusCode: http.StatusNotFound,
+			response:   `{"errors": "Not Found"}`,
+			get: func(ctx context.Context, client *Client) error {
+				_, err := client.GetPage(ctx, 1)
+				return err
+			},
+		},
+		{
+			name:       "GraphQL null object",
+			statusCode: http.StatusOK,
+			response:   `{"data": {"urlRedirect": null}}`,
+			get: func(ctx context.Context, client *Client) error {
+				_, err := client.GetURLRedirect(ctx, "gid://shopify/UrlRedirect/1")
+				return err
+			},
+		},
+		{
+			name:       "GraphQL null node",
+			statusCode: http.StatusOK,
+			response:   `{"data": {"node": null}}`,
+			get: func(ctx context.Context, client *Client) error {
+				_, err := client.GetMetafield(ctx, "gid://shopify/Metafield/1")
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				fmt.Fprint(w, tt.response)
+			})
+			if err := tt.get(context.Background(), client); !errors.Is(err, ErrNotFound) {
+				t.Errorf("got error %v, want ErrNotFound", err)
+			}
+		})
+	}
+}
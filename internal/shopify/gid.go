@@ -0,0 +1,33 @@
+package shopify
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var (
+	gidRegexp     = regexp.MustCompile(`^gid://shopify/([A-Za-z]+)/([^/?]+)(?:\?.*)?$`)
+	gidTypeRegexp = regexp.MustCompile(`^[A-Za-z]+$`)
+	gidIDRegexp   = regexp.MustCompile(`^[^/?]+$`)
+)
+
+// ParseGID returns the resource type and the ID of the given GID,
+// e.g. "Page" and "123" for "gid://shopify/Page/123". Query parameters of the GID are ignored.
+func ParseGID(gid string) (resourceType string, id string, ok bool) {
+	matches := gidRegexp.FindStringSubmatch(gid)
+	if matches == nil {
+		return "", "", false
+	}
+	return matches[1], matches[2], true
+}
+
+// BuildGID returns the GID of the resource, e.g. "gid://shopify/Page/123" for "Page" and "123".
+func BuildGID(resourceType string, id string) (string, error) {
+	if !gidTypeRegexp.MatchString(resourceType) {
+		return "", fmt.Errorf("%q is not a valid resource type, e.g. Page", resourceType)
+	}
+	if !gidIDRegexp.MatchString(id) {
+		return "", fmt.Errorf("%q is not a valid resource ID, e.g. 123", id)
+	}
+	return fmt.Sprintf("gid://shopify/%s/%s", resourceType, id), nil
+}
@@ -0,0 +1,157 @@
+package shopify
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const graphQLErrorCodeThrottled = "THROTTLED"
+
+// retryBaseDelay and retryMaxDelay bound the backoff delay of the retries. They are variables to be shortened in tests.
+var (
+	retryBaseDelay = 1 * time.Second
+	retryMaxDelay  = 30 * time.Second
+)
+
+type graphQLResponse struct {
+	Data       interface{}        `json:"data"`
+	Errors     []graphQLError     `json:"errors"`
+	Extensions *graphQLExtensions `json:"extensions"`
+}
+
+type graphQLExtensions struct {
+	Cost *QueryCost `json:"cost"`
+}
+
+type graphQLError struct {
+	Message    string `json:"message"`
+	Extensions *struct {
+		Code string `json:"code"`
+	} `json:"extensions"`
+}
+
+// query executes the GraphQL query and unmarshals the "data" portion of the response into resp.
+// The query waits until the rate limit points are available, and is retried with backoff
+// when it's throttled or fails with a server error. Mutations are not retried on server errors
+// since they may have been applied, e.g. retrying a create would create a duplicate.
+func (c *Client) query(ctx context.Context, query string, variables interface{}, resp interface{}) error {
+	body := struct {
+		Query     string      `json:"query"`
+		Variables interface{} `json:"variables"`
+	}{
+		Query:     query,
+		Variables: variables,
+	}
+
+	mutation := isMutation(query)
+	for attempt := 0; ; attempt++ {
+		throttled, err := c.doQuery(ctx, query, body, resp)
+		if err == nil {
+			return nil
+		}
+		if attempt >= c.maxRetries || !(throttled || isRetryableError(err, mutation)) {
+			return err
+		}
+
+		// Throttled requests are delayed by the rate limiter until the points are restored,
+		// unless the limiter doesn't know the throttle status yet.
+		var delay time.Duration
+		if !throttled || !c.rateLimiter.hasStatus() {
+			delay = retryDelay(attempt, err)
+		}
+		tflog.Debug(ctx, "retrying Shopify GraphQL request", map[string]interface{}{
+			"attempt":   attempt + 1,
+			"throttled": throttled,
+			"delay":     delay.String(),
+			"error":     err.Error(),
+		})
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// doQuery sends the GraphQL query once. It reports whether the query is throttled.
+func (c *Client) doQuery(ctx context.Context, query string, body interface{}, resp interface{}) (bool, error) {
+	if c.concurrency != nil {
+		select {
+		case c.concurrency <- struct{}{}:
+			defer func() { <-c.concurrency }()
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	}
+	if err := c.rateLimiter.wait(ctx, query); err != nil {
+		return false, err
+	}
+
+	gqlResp := graphQLResponse{Data: resp}
+	err := c.shopifyClient.Post(ctx, "graphql.json", body, &gqlResp)
+	if gqlResp.Extensions != nil && gqlResp.Extensions.Cost != nil {
+		c.rateLimiter.update(query, gqlResp.Extensions.Cost)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if len(gqlResp.Errors) > 0 {
+		responseError := goshopify.ResponseError{Status: http.StatusOK}
+		throttled := false
+		for _, gqlErr := range gqlResp.Errors {
+			if gqlErr.Extensions != nil && gqlErr.Extensions.Code == graphQLErrorCodeThrottled {
+				throttled = true
+			}
+			responseError.Errors = append(responseError.Errors, gqlErr.Message)
+		}
+		return throttled, responseError
+	}
+	return false, nil
+}
+
+// isMutation reports whether the GraphQL document is a mutation.
+func isMutation(query string) bool {
+	return strings.HasPrefix(strings.TrimSpace(query), "mutation")
+}
+
+// isRetryableError reports whether the request can be retried. Rate limited requests are rejected before processed,
+// so they are always retryable, while server errors are only retryable for queries.
+func isRetryableError(err error, mutation bool) bool {
+	var rateLimitErr goshopify.RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return true
+	}
+	if mutation {
+		return false
+	}
+	var responseErr goshopify.ResponseError
+	if errors.As(err, &responseErr) {
+		return responseErr.Status >= http.StatusInternalServerError
+	}
+	var decodingErr goshopify.ResponseDecodingError
+	if errors.As(err, &decodingErr) {
+		return decodingErr.Status >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// retryDelay returns the exponential backoff delay with jitter, or the delay requested by Retry-After.
+func retryDelay(attempt int, err error) time.Duration {
+	var rateLimitErr goshopify.RateLimitError
+	if errors.As(err, &rateLimitErr) && rateLimitErr.RetryAfter > 0 {
+		return time.Duration(rateLimitErr.RetryAfter) * time.Second
+	}
+	delay := time.Duration(math.Min(
+		float64(retryBaseDelay)*math.Pow(2, float64(attempt)),
+		float64(retryMaxDelay),
+	))
+	// Add up to 50% jitter not to retry concurrent requests at the same time.
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
@@ -0,0 +1,284 @@
+package shopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+// testServerTransport sends the requests to the test server instead of the shop.
+type testServerTransport struct {
+	url *url.URL
+}
+
+func (t testServerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.url.Scheme
+	req.URL.Host = t.url.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...Option) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	serverURL, _ := url.Parse(server.URL)
+	shopifyClient, err := goshopify.NewClient(goshopify.App{}, "test", "token", goshopify.WithHTTPClient(&http.Client{
+		Transport: testServerTransport{url: serverURL},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewClient(shopifyClient, opts...)
+}
+
+// shortenRetryDelays shortens the backoff delays during the test.
+func shortenRetryDelays(t *testing.T) {
+	t.Helper()
+	baseDelay, maxDelay := retryBaseDelay, retryMaxDelay
+	retryBaseDelay, retryMaxDelay = 2*time.Millisecond, 10*time.Millisecond
+	t.Cleanup(func() {
+		retryBaseDelay, retryMaxDelay = baseDelay, maxDelay
+	})
+}
+
+const (
+	testQuery    = `query shop { shop { name } }`
+	testMutation = `mutation deleteThing($id: ID!) { thingDelete(id: $id) { deletedId } }`
+
+	testDataResponse      = `{"data": {"shop": {"name": "test"}}}`
+	testThrottledResponse = `{"errors": [{"message": "Throttled", "extensions": {"code": "THROTTLED"}}]}`
+)
+
+func TestClient_query(t *testing.T) {
+	shortenRetryDelays(t)
+
+	tests := []struct {
+		name  string
+		query string
+		// responses are the status codes and bodies of the responses in order. The last one is repeated.
+		responses    []string
+		statusCodes  []int
+		opts         []Option
+		wantRequests int32
+		wantErr      bool
+	}{
+		{
+			name:         "succeeds without retries",
+			query:        testQuery,
+			statusCodes:  []int{http.StatusOK},
+			responses:    []string{testDataResponse},
+			wantRequests: 1,
+		},
+		{
+			name:         "retries server errors of queries",
+			query:        testQuery,
+			statusCodes:  []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK},
+			responses:    []string{`{"errors": "Internal Server Error"}`, `{"errors": "Bad Gateway"}`, testDataResponse},
+			wantRequests: 3,
+		},
+		{
+			name:         "doesn't retry server errors of mutations",
+			query:        testMutation,
+			statusCodes:  []int{http.StatusInternalServerError, http.StatusOK},
+			responses:    []string{`{"errors": "Internal Server Error"}`, testDataResponse},
+			wantRequests: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "retries throttled mutations",
+			query:        testMutation,
+			statusCodes:  []int{http.StatusOK, http.StatusOK},
+			responses:    []string{testThrottledResponse, testDataResponse},
+			wantRequests: 2,
+		},
+		{
+			name:         "retries rate limited mutations",
+			query:        testMutation,
+			statusCodes:  []int{http.StatusTooManyRequests, http.StatusOK},
+			responses:    []string{`{"errors": "Exceeded 2 calls per second for api client."}`, testDataResponse},
+			wantRequests: 2,
+		},
+		{
+			name:         "doesn't retry client errors",
+			query:        testQuery,
+			statusCodes:  []int{http.StatusBadRequest},
+			responses:    []string{`{"errors": "Bad Request"}`},
+			wantRequests: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "gives up after the max retries",
+			query:        testQuery,
+			statusCodes:  []int{http.StatusServiceUnavailable},
+			responses:    []string{`{"errors": "Service Unavailable"}`},
+			opts:         []Option{WithMaxRetries(2)},
+			wantRequests: 3,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				i := int(requests.Add(1)) - 1
+				if i >= len(tt.responses) {
+					i = len(tt.responses) - 1
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCodes[i])
+				fmt.Fprint(w, tt.responses[i])
+			}, tt.opts...)
+
+			var resp struct {
+				Shop struct {
+					Name string `json:"name"`
+				} `json:"shop"`
+			}
+			err := client.query(context.Background(), tt.query, nil, &resp)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("query() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := requests.Load(); got != tt.wantRequests {
+				t.Errorf("query() sent %d requests, want %d", got, tt.wantRequests)
+			}
+			if !tt.wantErr && resp.Shop.Name != "test" {
+				t.Errorf("query() got shop name %q, want %q", resp.Shop.Name, "test")
+			}
+		})
+	}
+}
+
+func TestClient_query_throttled(t *testing.T) {
+	shortenRetryDelays(t)
+
+	t.Run("waits until the points are restored", func(t *testing.T) {
+		var requests atomic.Int32
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if requests.Add(1) == 1 {
+				// 50 points are restored in 50ms.
+				fmt.Fprint(w, `{"errors": [{"message": "Throttled", "extensions": {"code": "THROTTLED"}}], "extensions": {"cost": {"requestedQueryCost": 50, "throttleStatus": {"maximumAvailable": 1000, "currentlyAvailable": 0, "restoreRate": 1000}}}}`)
+				return
+			}
+			fmt.Fprint(w, testDataResponse)
+		})
+
+		start := time.Now()
+		if err := client.query(context.Background(), testQuery, nil, &struct{}{}); err != nil {
+			t.Fatal(err)
+		}
+		if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+			t.Errorf("query() retried after %s, want after the points are restored in 50ms", elapsed)
+		}
+		if got := requests.Load(); got != 2 {
+			t.Errorf("query() sent %d requests, want 2", got)
+		}
+	})
+
+	t.Run("backs off without the throttle status", func(t *testing.T) {
+		var requestTimes []time.Time
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			requestTimes = append(requestTimes, time.Now())
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, testThrottledResponse)
+		}, WithMaxRetries(3))
+
+		if err := client.query(context.Background(), testQuery, nil, &struct{}{}); err == nil {
+			t.Fatal("query() error = nil, want the throttled error")
+		}
+		if len(requestTimes) != 4 {
+			t.Fatalf("query() sent %d requests, want 4", len(requestTimes))
+		}
+		for i := 1; i < len(requestTimes); i++ {
+			// The delay of the attempt is at least half of the exponential backoff.
+			minDelay := retryBaseDelay * time.Duration(1<<(i-1)) / 2
+			if delay := requestTimes[i].Sub(requestTimes[i-1]); delay < minDelay {
+				t.Errorf("retry %d was sent after %s, want at least %s", i, delay, minDelay)
+			}
+		}
+	})
+}
+
+func TestIsMutation(t *testing.T) {
+	tests := map[string]bool{
+		testQuery:                             false,
+		testMutation:                          true,
+		"\n  mutation { thingDelete { id } }": true,
+		"{ shop { name } }":                   false,
+	}
+	for query, want := range tests {
+		if got := isMutation(query); got != want {
+			t.Errorf("isMutation(%q) = %v, want %v", query, got, want)
+		}
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	rateLimitErr := goshopify.RateLimitError{ResponseError: goshopify.ResponseError{Status: http.StatusTooManyRequests}, RetryAfter: 1}
+	tests := []struct {
+		name         string
+		err          error
+		wantQuery    bool
+		wantMutation bool
+	}{
+		{name: "rate limit", err: rateLimitErr, wantQuery: true, wantMutation: true},
+		{name: "wrapped rate limit", err: fmt.Errorf("request: %w", rateLimitErr), wantQuery: true, wantMutation: true},
+		{name: "server error", err: goshopify.ResponseError{Status: http.StatusInternalServerError}, wantQuery: true, wantMutation: false},
+		{name: "unavailable", err: goshopify.ResponseError{Status: http.StatusServiceUnavailable}, wantQuery: true, wantMutation: false},
+		{name: "decoding error of server error", err: goshopify.ResponseDecodingError{Status: http.StatusBadGateway}, wantQuery: true, wantMutation: false},
+		{name: "decoding error", err: goshopify.ResponseDecodingError{Status: http.StatusOK}, wantQuery: false, wantMutation: false},
+		{name: "client error", err: goshopify.ResponseError{Status: http.StatusNotFound}, wantQuery: false, wantMutation: false},
+		{name: "graphql error", err: goshopify.ResponseError{Status: http.StatusOK, Errors: []string{"invalid"}}, wantQuery: false, wantMutation: false},
+		{name: "other error", err: errors.New("connection reset"), wantQuery: false, wantMutation: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryableError(tt.err, false); got != tt.wantQuery {
+				t.Errorf("isRetryableError(query) = %v, want %v", got, tt.wantQuery)
+			}
+			if got := isRetryableError(tt.err, true); got != tt.wantMutation {
+				t.Errorf("isRetryableError(mutation) = %v, want %v", got, tt.wantMutation)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	t.Run("Retry-After", func(t *testing.T) {
+		err := goshopify.RateLimitError{ResponseError: goshopify.ResponseError{Status: http.StatusTooManyRequests}, RetryAfter: 3}
+		if got := retryDelay(0, err); got != 3*time.Second {
+			t.Errorf("retryDelay() = %s, want 3s", got)
+		}
+	})
+
+	t.Run("rate limit without Retry-After", func(t *testing.T) {
+		err := goshopify.RateLimitError{ResponseError: goshopify.ResponseError{Status: http.StatusTooManyRequests}}
+		if got := retryDelay(0, err); got < retryBaseDelay/2 || got > retryBaseDelay {
+			t.Errorf("retryDelay() = %s, want the backoff delay between %s and %s", got, retryBaseDelay/2, retryBaseDelay)
+		}
+	})
+
+	t.Run("exponential backoff", func(t *testing.T) {
+		err := goshopify.ResponseError{Status: http.StatusInternalServerError}
+		for attempt := 0; attempt < 10; attempt++ {
+			backoff := retryBaseDelay * time.Duration(1<<attempt)
+			if backoff > retryMaxDelay {
+				backoff = retryMaxDelay
+			}
+			for i := 0; i < 20; i++ {
+				if got := retryDelay(attempt, err); got < backoff/2 || got > backoff {
+					t.Fatalf("retryDelay(%d) = %s, want between %s and %s", attempt, got, backoff/2, backoff)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,179 @@
+package shopify
+
+import (
+	"context"
+)
+
+type Menu struct {
+	ID        string      `json:"id"`
+	Handle    string      `json:"handle"`
+	Title     string      `json:"title"`
+	IsDefault bool        `json:"isDefault"`
+	Items     []*MenuItem `json:"items"`
+}
+
+type MenuItem struct {
+	ID         string      `json:"id"`
+	Title      string      `json:"title"`
+	Type       string      `json:"type"`
+	ResourceID *string     `json:"resourceId"`
+	URL        *string     `json:"url"`
+	Tags       []string    `json:"tags"`
+	Items      []*MenuItem `json:"items"`
+}
+
+// MenuItemInput represents both MenuItemCreateInput and MenuItemUpdateInput.
+type MenuItemInput struct {
+	ID         *string          `json:"id,omitempty"`
+	Title      string           `json:"title"`
+	Type       string           `json:"type"`
+	ResourceID *string          `json:"resourceId,omitempty"`
+	URL        *string          `json:"url,omitempty"`
+	Tags       []string         `json:"tags"`
+	Items      []*MenuItemInput `json:"items"`
+}
+
+// Menus support up to 3 levels of items.
+const menuFields = `
+      id
+      handle
+      title
+      isDefault
+      items {
+        id
+        title
+        type
+        resourceId
+        url
+        tags
+        items {
+          id
+          title
+          type
+          resourceId
+          url
+          tags
+          items {
+            id
+            title
+            type
+            resourceId
+            url
+            tags
+          }
+        }
+      }
+`
+
+type MenuMutationResponse struct {
+	Result struct {
+		Menu       *Menu      `json:"menu"`
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"result"`
+}
+
+func (c *Client) CreateMenu(ctx context.Context, title string, handle string, items []*MenuItemInput) (*Menu, error) {
+	variables := map[string]interface{}{"title": title, "handle": handle, "items": items}
+	query := `
+mutation CreateMenu($title: String!, $handle: String!, $items: [MenuItemCreateInput!]!) {
+  result: menuCreate(title: $title, handle: $handle, items: $items) {
+    menu {` + menuFields + `    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp MenuMutationResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.Result.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.Result.Menu, nil
+}
+
+type GetMenuResponse struct {
+	Menu *Menu `json:"menu"`
+}
+
+func (c *Client) GetMenu(ctx context.Context, id string) (*Menu, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query menu($id: ID!) {
+  menu(id: $id) {` + menuFields + `  }
+}
+`
+
+	var gqlResp GetMenuResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if gqlResp.Menu == nil {
+		return nil, newNotFoundError("menu", id)
+	}
+	return gqlResp.Menu, nil
+}
+
+// UpdateMenu replaces the title, the handle and all the items of the menu.
+// Items without ID are newly created and existing items which are not in the input are deleted.
+func (c *Client) UpdateMenu(ctx context.Context, id string, title string, handle string, items []*MenuItemInput) (*Menu, error) {
+	variables := map[string]interface{}{"id": id, "title": title, "handle": handle, "items": items}
+	query := `
+mutation UpdateMenu($id: ID!, $title: String!, $handle: String, $items: [MenuItemUpdateInput!]!) {
+  result: menuUpdate(id: $id, title: $title, handle: $handle, items: $items) {
+    menu {` + menuFields + `    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp MenuMutationResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.Result.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.Result.Menu, nil
+}
+
+func (c *Client) DeleteMenu(ctx context.Context, id string) error {
+	variables := map[string]interface{}{"id": id}
+	query := `
+mutation DeleteMenu($id: ID!) {
+  menuDelete(id: $id) {
+    deletedMenuId
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	type DeleteMenuResponse struct {
+		MenuDelete struct {
+			DeletedMenuID string     `json:"deletedMenuId"`
+			UserErrors    UserErrors `json:"userErrors"`
+		} `json:"menuDelete"`
+	}
+	var gqlResp DeleteMenuResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	if err := gqlResp.MenuDelete.UserErrors.Error(); err != nil {
+		return err
+	}
+	return nil
+}
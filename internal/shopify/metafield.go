@@ -0,0 +1,171 @@
+package shopify
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+type Metafield struct {
+	ID        string `json:"id"`
+	Namespace string `json:"namespace"`
+	Key       string `json:"key"`
+	Type      string `json:"type"`
+	Value     string `json:"value"`
+	Owner     *struct {
+		ID string `json:"id"`
+	} `json:"owner"`
+}
+
+type MetafieldsSetInput struct {
+	OwnerID   string `json:"ownerId"`
+	Namespace string `json:"namespace"`
+	Key       string `json:"key"`
+	Type      string `json:"type,omitempty"`
+	Value     string `json:"value"`
+}
+
+type MetafieldIdentifierInput struct {
+	OwnerID   string `json:"ownerId"`
+	Namespace string `json:"namespace"`
+	Key       string `json:"key"`
+}
+
+// irregularMetafieldOwnerTypes maps GID resource types whose MetafieldOwnerType
+// is not simply the upper-cased resource type.
+var irregularMetafieldOwnerTypes = map[string]string{
+	"CompanyLocation":           "COMPANY_LOCATION",
+	"DeliveryCustomization":     "DELIVERY_CUSTOMIZATION",
+	"FulfillmentConstraintRule": "FULFILLMENT_CONSTRAINT_RULE",
+	"MediaImage":                "MEDIA_IMAGE",
+	"OrderRoutingLocationRule":  "ORDER_ROUTING_LOCATION_RULE",
+	"PaymentCustomization":      "PAYMENT_CUSTOMIZATION",
+}
+
+// MetafieldOwnerTypeFromGID returns the MetafieldOwnerType of the resource identified by the given GID,
+// e.g. "PRODUCTVARIANT" for "gid://shopify/ProductVariant/1".
+func MetafieldOwnerTypeFromGID(gid string) (string, bool) {
+	resourceType, _, ok := ParseGID(gid)
+	if !ok {
+		return "", false
+	}
+	if ownerType, ok := irregularMetafieldOwnerTypes[resourceType]; ok {
+		return ownerType, true
+	}
+	return strings.ToUpper(resourceType), true
+}
+
+func (c *Client) SetMetafield(ctx context.Context, input *MetafieldsSetInput) (*Metafield, error) {
+	variables := map[string]interface{}{"metafields": []*MetafieldsSetInput{input}}
+	query := `
+mutation SetMetafields($metafields: [MetafieldsSetInput!]!) {
+  metafieldsSet(metafields: $metafields) {
+    metafields {
+      id
+      namespace
+      key
+      type
+      value
+      owner {
+        ... on Node {
+          id
+        }
+      }
+    }
+    userErrors {
+      field
+      message
+      code
+      elementIndex
+    }
+  }
+}`
+
+	type SetMetafieldsResponse struct {
+		MetafieldsSet struct {
+			Metafields []*Metafield `json:"metafields"`
+			UserErrors UserErrors   `json:"userErrors"`
+		} `json:"metafieldsSet"`
+	}
+	var gqlResp SetMetafieldsResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.MetafieldsSet.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	if len(gqlResp.MetafieldsSet.Metafields) == 0 {
+		return nil, errors.New("metafieldsSet returned no metafield")
+	}
+	return gqlResp.MetafieldsSet.Metafields[0], nil
+}
+
+type GetMetafieldResponse struct {
+	Node *Metafield `json:"node"`
+}
+
+func (c *Client) GetMetafield(ctx context.Context, id string) (*Metafield, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query metafield($id: ID!) {
+  node(id: $id) {
+    ... on Metafield {
+      id
+      namespace
+      key
+      type
+      value
+      owner {
+        ... on Node {
+          id
+        }
+      }
+    }
+  }
+}
+`
+
+	var gqlResp GetMetafieldResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if gqlResp.Node == nil {
+		return nil, newNotFoundError("metafield", id)
+	}
+	return gqlResp.Node, nil
+}
+
+func (c *Client) DeleteMetafield(ctx context.Context, input *MetafieldIdentifierInput) error {
+	variables := map[string]interface{}{"metafields": []*MetafieldIdentifierInput{input}}
+	query := `
+mutation DeleteMetafields($metafields: [MetafieldIdentifierInput!]!) {
+  metafieldsDelete(metafields: $metafields) {
+    deletedMetafields {
+      ownerId
+      namespace
+      key
+    }
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+	type DeleteMetafieldsResponse struct {
+		MetafieldsDelete struct {
+			UserErrors UserErrors `json:"userErrors"`
+		} `json:"metafieldsDelete"`
+	}
+	var gqlResp DeleteMetafieldsResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	if err := gqlResp.MetafieldsDelete.UserErrors.Error(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -2,6 +2,11 @@ package shopify
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type MetafieldDefinition struct {
@@ -14,6 +19,28 @@ type MetafieldDefinition struct {
 	Type           *MetafieldDefinitionType         `json:"type"`
 	PinnedPosition *int                             `json:"pinnedPosition"`
 	Validations    []*MetafieldDefinitionValidation `json:"validations"`
+	Access         *MetafieldAccess                 `json:"access"`
+	Capabilities   *MetafieldCapabilities           `json:"capabilities"`
+}
+
+// MetafieldAccess is the access settings of the metafields of a definition.
+// The fields are omitted from the input when empty so that Shopify keeps the current or the default settings.
+type MetafieldAccess struct {
+	// Admin can only be set for the definitions in the namespaces reserved by apps.
+	Admin           string `json:"admin,omitempty"`
+	Storefront      string `json:"storefront,omitempty"`
+	CustomerAccount string `json:"customerAccount,omitempty"`
+}
+
+// MetafieldCapabilities are the capabilities of a definition. It's also used as the input,
+// where the capabilities omitted are left unchanged.
+type MetafieldCapabilities struct {
+	AdminFilterable          *MetafieldCapability `json:"adminFilterable,omitempty"`
+	SmartCollectionCondition *MetafieldCapability `json:"smartCollectionCondition,omitempty"`
+}
+
+type MetafieldCapability struct {
+	Enabled bool `json:"enabled"`
 }
 
 type MetafieldDefinitionType struct {
@@ -21,20 +48,71 @@ type MetafieldDefinitionType struct {
 	Name     string `json:"name"`
 }
 
+// jsonMetafieldTypes are the non-list types whose values are serialized as JSON.
+var jsonMetafieldTypes = map[string]bool{
+	"dimension":       true,
+	"json":            true,
+	"link":            true,
+	"money":           true,
+	"rating":          true,
+	"rich_text_field": true,
+	"volume":          true,
+	"weight":          true,
+}
+
+// IsJSON reports whether values of the type are serialized as JSON.
+func (t *MetafieldDefinitionType) IsJSON() bool {
+	return strings.HasPrefix(t.Name, "list.") || jsonMetafieldTypes[t.Name]
+}
+
+// ValidateValue reports an error when the value can't be stored in a metafield of the type.
+// Only the format is validated, so the value may still be rejected by Shopify.
+func (t *MetafieldDefinitionType) ValidateValue(value string) error {
+	if t.IsJSON() {
+		if !json.Valid([]byte(value)) {
+			return fmt.Errorf("value of %s type must be valid JSON", t.Name)
+		}
+		return nil
+	}
+
+	var err error
+	switch t.Name {
+	case "boolean":
+		if value != "true" && value != "false" {
+			err = fmt.Errorf("value of %s type must be true or false", t.Name)
+		}
+	case "number_integer":
+		if _, parseErr := strconv.ParseInt(value, 10, 64); parseErr != nil {
+			err = fmt.Errorf("value of %s type must be an integer", t.Name)
+		}
+	case "number_decimal":
+		if _, parseErr := strconv.ParseFloat(value, 64); parseErr != nil {
+			err = fmt.Errorf("value of %s type must be a decimal number", t.Name)
+		}
+	case "date":
+		if _, parseErr := time.Parse(time.DateOnly, value); parseErr != nil {
+			err = fmt.Errorf("value of %s type must be formatted as YYYY-MM-DD", t.Name)
+		}
+	}
+	return err
+}
+
 type MetafieldDefinitionValidation struct {
 	Name  string `json:"name"`
 	Value string `json:"value"`
 }
 
 type MetafieldDefinitionInput struct {
-	Name        string                           `json:"name"`
-	Description string                           `json:"description,omitempty"`
-	OwnerType   string                           `json:"ownerType"`
-	Namespace   string                           `json:"namespace"`
-	Key         string                           `json:"key"`
-	Type        string                           `json:"type"`
-	Pin         bool                             `json:"pin"`
-	Validations []*MetafieldDefinitionValidation `json:"validations"`
+	Name         string                           `json:"name"`
+	Description  string                           `json:"description,omitempty"`
+	OwnerType    string                           `json:"ownerType"`
+	Namespace    string                           `json:"namespace"`
+	Key          string                           `json:"key"`
+	Type         string                           `json:"type"`
+	Pin          bool                             `json:"pin"`
+	Validations  []*MetafieldDefinitionValidation `json:"validations"`
+	Access       *MetafieldAccess                 `json:"access,omitempty"`
+	Capabilities *MetafieldCapabilities           `json:"capabilities,omitempty"`
 }
 
 type CreateMetafieldDefinitionResponse struct {
@@ -65,6 +143,19 @@ mutation CreateMetafieldDefinition($definition: MetafieldDefinitionInput!) {
         name	
         value
       }
+      access {
+        admin
+        storefront
+        customerAccount
+      }
+      capabilities {
+        adminFilterable {
+          enabled
+        }
+        smartCollectionCondition {
+          enabled
+        }
+      }
     }
     userErrors {
       field
@@ -75,7 +166,7 @@ mutation CreateMetafieldDefinition($definition: MetafieldDefinitionInput!) {
 }`
 
 	var gqlResp CreateMetafieldDefinitionResponse
-	err := c.shopifyClient.GraphQL.Query(ctx, query, variables, &gqlResp)
+	err := c.query(ctx, query, variables, &gqlResp)
 	if err != nil {
 		return nil, err
 	}
@@ -109,26 +200,169 @@ query metafieldDefinition($id: ID!) {
       name	
       value
     }
+    access {
+      admin
+      storefront
+      customerAccount
+    }
+    capabilities {
+      adminFilterable {
+        enabled
+      }
+      smartCollectionCondition {
+        enabled
+      }
+    }
   }
 }
 `
 
 	var gqlResp GetMetafieldDefinitionResponse
-	err := c.shopifyClient.GraphQL.Query(ctx, query, variables, &gqlResp)
+	err := c.query(ctx, query, variables, &gqlResp)
 	if err != nil {
 		return nil, err
 	}
+	if gqlResp.MetafieldDefinition == nil {
+		return nil, newNotFoundError("metafield definition", id)
+	}
 	return gqlResp.MetafieldDefinition, nil
 }
 
+type FindMetafieldDefinitionResponse struct {
+	MetafieldDefinitions struct {
+		Nodes []*MetafieldDefinition `json:"nodes"`
+	} `json:"metafieldDefinitions"`
+}
+
+// FindMetafieldDefinition looks up the metafield definition by owner type, namespace and key.
+// nil is returned when no definition matches.
+func (c *Client) FindMetafieldDefinition(ctx context.Context, ownerType, namespace, key string) (*MetafieldDefinition, error) {
+	variables := map[string]interface{}{"ownerType": ownerType, "namespace": namespace, "key": key}
+	query := `
+query metafieldDefinitions($ownerType: MetafieldOwnerType!, $namespace: String!, $key: String!) {
+  metafieldDefinitions(first: 1, ownerType: $ownerType, namespace: $namespace, key: $key) {
+    nodes {
+      id
+      name
+      description
+      key
+      namespace
+      ownerType
+      type {
+        category
+        name
+      }
+      pinnedPosition
+      validations {
+        name
+        value
+      }
+      access {
+        admin
+        storefront
+        customerAccount
+      }
+      capabilities {
+        adminFilterable {
+          enabled
+        }
+        smartCollectionCondition {
+          enabled
+        }
+      }
+    }
+  }
+}
+`
+
+	var gqlResp FindMetafieldDefinitionResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if len(gqlResp.MetafieldDefinitions.Nodes) == 0 {
+		return nil, nil
+	}
+	return gqlResp.MetafieldDefinitions.Nodes[0], nil
+}
+
+type ListMetafieldDefinitionsResponse struct {
+	MetafieldDefinitions struct {
+		Nodes    []*MetafieldDefinition `json:"nodes"`
+		PageInfo PageInfo               `json:"pageInfo"`
+	} `json:"metafieldDefinitions"`
+}
+
+// ListMetafieldDefinitions returns all metafield definitions of the owner type.
+func (c *Client) ListMetafieldDefinitions(ctx context.Context, ownerType string) ([]*MetafieldDefinition, error) {
+	query := `
+query metafieldDefinitions($ownerType: MetafieldOwnerType!, $after: String) {
+  metafieldDefinitions(first: 250, ownerType: $ownerType, after: $after) {
+    nodes {
+      id
+      name
+      description
+      key
+      namespace
+      ownerType
+      type {
+        category
+        name
+      }
+      pinnedPosition
+      validations {
+        name
+        value
+      }
+      access {
+        admin
+        storefront
+        customerAccount
+      }
+      capabilities {
+        adminFilterable {
+          enabled
+        }
+        smartCollectionCondition {
+          enabled
+        }
+      }
+    }
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+  }
+}
+`
+
+	var definitions []*MetafieldDefinition
+	var after *string
+	for {
+		variables := map[string]interface{}{"ownerType": ownerType, "after": after}
+		var gqlResp ListMetafieldDefinitionsResponse
+		err := c.query(ctx, query, variables, &gqlResp)
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, gqlResp.MetafieldDefinitions.Nodes...)
+		if !gqlResp.MetafieldDefinitions.PageInfo.HasNextPage {
+			return definitions, nil
+		}
+		after = gqlResp.MetafieldDefinitions.PageInfo.EndCursor
+	}
+}
+
 type MetafieldDefinitionUpdateInput struct {
-	Name        string                           `json:"name"`
-	Description string                           `json:"description,omitempty"`
-	OwnerType   string                           `json:"ownerType"`
-	Namespace   string                           `json:"namespace"`
-	Key         string                           `json:"key"`
-	Pin         bool                             `json:"pin"`
-	Validations []*MetafieldDefinitionValidation `json:"validations"`
+	Name         string                           `json:"name"`
+	Description  string                           `json:"description,omitempty"`
+	OwnerType    string                           `json:"ownerType"`
+	Namespace    string                           `json:"namespace"`
+	Key          string                           `json:"key"`
+	Pin          bool                             `json:"pin"`
+	Validations  []*MetafieldDefinitionValidation `json:"validations"`
+	Access       *MetafieldAccess                 `json:"access,omitempty"`
+	Capabilities *MetafieldCapabilities           `json:"capabilities,omitempty"`
 }
 
 type UpdateMetafieldDefinitionResponse struct {
@@ -159,6 +393,19 @@ mutation UpdateMetafieldDefinition($definition: MetafieldDefinitionUpdateInput!)
         name	
         value
       }
+      access {
+        admin
+        storefront
+        customerAccount
+      }
+      capabilities {
+        adminFilterable {
+          enabled
+        }
+        smartCollectionCondition {
+          enabled
+        }
+      }
     }
     userErrors {
       field
@@ -169,7 +416,7 @@ mutation UpdateMetafieldDefinition($definition: MetafieldDefinitionUpdateInput!)
 }`
 
 	var gqlResp UpdateMetafieldDefinitionResponse
-	err := c.shopifyClient.GraphQL.Query(ctx, query, variables, &gqlResp)
+	err := c.query(ctx, query, variables, &gqlResp)
 	if err != nil {
 		return nil, err
 	}
@@ -201,7 +448,7 @@ mutation DeleteMetafieldDefinition($id: ID!) {
 }`
 
 	var gqlResp DeleteMetafieldDefinitionResponse
-	err := c.shopifyClient.GraphQL.Query(ctx, query, variables, &gqlResp)
+	err := c.query(ctx, query, variables, &gqlResp)
 	if err != nil {
 		return err
 	}
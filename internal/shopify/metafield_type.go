@@ -0,0 +1,126 @@
+package shopify
+
+import (
+	"sort"
+	"strings"
+)
+
+// listMetafieldTypePrefix is the prefix of the types storing a list of values of the base type, e.g. "list.product_reference".
+const listMetafieldTypePrefix = "list."
+
+// listMetafieldValidations are the validations accepted by every list type in addition to the ones of the base type,
+// limiting the number of the values.
+var listMetafieldValidations = []string{"list.min", "list.max"}
+
+// MetafieldTypeInfo describes a metafield type and the validations it accepts.
+type MetafieldTypeInfo struct {
+	Name     string
+	Category string
+	// SupportedValidations are the names of the validations the type accepts.
+	SupportedValidations []string
+}
+
+// SupportsValidation reports whether the type accepts the validation.
+func (t *MetafieldTypeInfo) SupportsValidation(name string) bool {
+	for _, v := range t.SupportedValidations {
+		if v == name {
+			return true
+		}
+	}
+	return false
+}
+
+type baseMetafieldType struct {
+	category             string
+	supportedValidations []string
+	// hasList reports whether the type has the list. variant.
+	hasList bool
+}
+
+// baseMetafieldTypes are the supported metafield types except the list. variants.
+// https://shopify.dev/docs/apps/build/custom-data/metafields/list-of-data-types
+// https://shopify.dev/docs/apps/build/custom-data/metafields/list-of-validation-options
+var baseMetafieldTypes = map[string]baseMetafieldType{
+	"boolean":                {category: "TRUE_FALSE"},
+	"collection_reference":   {category: "REFERENCE", hasList: true},
+	"color":                  {category: "COLOR", hasList: true},
+	"company_reference":      {category: "REFERENCE", hasList: true},
+	"customer_reference":     {category: "REFERENCE", hasList: true},
+	"date":                   {category: "DATE_TIME", supportedValidations: []string{"min", "max"}, hasList: true},
+	"date_time":              {category: "DATE_TIME", supportedValidations: []string{"min", "max"}, hasList: true},
+	"dimension":              {category: "MEASUREMENT", supportedValidations: []string{"min", "max"}, hasList: true},
+	"file_reference":         {category: "REFERENCE", supportedValidations: []string{"file_type_options"}, hasList: true},
+	"id":                     {category: "TEXT", supportedValidations: []string{"regex"}},
+	"json":                   {category: "JSON", supportedValidations: []string{"schema"}},
+	"link":                   {category: "URL", supportedValidations: []string{"allowed_domains"}, hasList: true},
+	"metaobject_reference":   {category: "REFERENCE", supportedValidations: []string{"metaobject_definition_id"}, hasList: true},
+	"mixed_reference":        {category: "REFERENCE", supportedValidations: []string{"metaobject_definition_ids"}, hasList: true},
+	"money":                  {category: "MONEY"},
+	"multi_line_text_field":  {category: "TEXT", supportedValidations: []string{"min", "max", "regex"}},
+	"number_decimal":         {category: "NUMBER", supportedValidations: []string{"min", "max", "max_precision"}, hasList: true},
+	"number_integer":         {category: "NUMBER", supportedValidations: []string{"min", "max"}, hasList: true},
+	"page_reference":         {category: "REFERENCE", hasList: true},
+	"product_reference":      {category: "REFERENCE", hasList: true},
+	"rating":                 {category: "RATING", supportedValidations: []string{"scale_min", "scale_max"}, hasList: true},
+	"rich_text_field":        {category: "TEXT"},
+	"single_line_text_field": {category: "TEXT", supportedValidations: []string{"min", "max", "regex", "choices"}, hasList: true},
+	"url":                    {category: "URL", supportedValidations: []string{"allowed_domains"}, hasList: true},
+	"variant_reference":      {category: "REFERENCE", hasList: true},
+	"volume":                 {category: "MEASUREMENT", supportedValidations: []string{"min", "max"}, hasList: true},
+	"weight":                 {category: "MEASUREMENT", supportedValidations: []string{"min", "max"}, hasList: true},
+}
+
+// LookupMetafieldType returns the metafield type of the name, including the list. variants.
+func LookupMetafieldType(name string) (*MetafieldTypeInfo, bool) {
+	baseName, isList := strings.CutPrefix(name, listMetafieldTypePrefix)
+	baseType, ok := baseMetafieldTypes[baseName]
+	if !ok || (isList && !baseType.hasList) {
+		return nil, false
+	}
+	info := &MetafieldTypeInfo{
+		Name:                 name,
+		Category:             baseType.category,
+		SupportedValidations: append([]string(nil), baseType.supportedValidations...),
+	}
+	if isList {
+		info.SupportedValidations = append(info.SupportedValidations, listMetafieldValidations...)
+	}
+	return info, true
+}
+
+// MetafieldTypeNames returns the names of the supported metafield types in alphabetical order, including the list. variants.
+func MetafieldTypeNames() []string {
+	var names []string
+	for name, baseType := range baseMetafieldTypes {
+		names = append(names, name)
+		if baseType.hasList {
+			names = append(names, listMetafieldTypePrefix+name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// metafieldTypeMigrations are the type changes Shopify applies in place keeping the stored values,
+// in addition to the change from a type to its list. variant.
+var metafieldTypeMigrations = map[string][]string{
+	"single_line_text_field": {"multi_line_text_field"},
+}
+
+// CanMigrateMetafieldType reports whether a field of the type can be changed to the other type in place
+// without deleting the stored values.
+func CanMigrateMetafieldType(from string, to string) bool {
+	if from == to {
+		return true
+	}
+	if to == listMetafieldTypePrefix+from {
+		baseType, ok := baseMetafieldTypes[from]
+		return ok && baseType.hasList
+	}
+	for _, migratable := range metafieldTypeMigrations[from] {
+		if migratable == to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package shopify
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLookupMetafieldType(t *testing.T) {
+	tests := []struct {
+		name                     string
+		wantOK                   bool
+		wantCategory             string
+		wantSupportedValidations []string
+	}{
+		{name: "single_line_text_field", wantOK: true, wantCategory: "TEXT", wantSupportedValidations: []string{"min", "max", "regex", "choices"}},
+		{name: "list.single_line_text_field", wantOK: true, wantCategory: "TEXT", wantSupportedValidations: []string{"min", "max", "regex", "choices", "list.min", "list.max"}},
+		{name: "boolean", wantOK: true, wantCategory: "TRUE_FALSE", wantSupportedValidations: []string{}},
+		{name: "list.boolean", wantOK: false},
+		{name: "single_line_text", wantOK: false},
+		{name: "list.", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := LookupMetafieldType(tt.name)
+			if ok != tt.wantOK {
+				t.Fatalf("LookupMetafieldType() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if got.Name != tt.name || got.Category != tt.wantCategory {
+				t.Errorf("LookupMetafieldType() = %s of %s, want %s of %s", got.Name, got.Category, tt.name, tt.wantCategory)
+			}
+			if !slices.Equal(got.SupportedValidations, tt.wantSupportedValidations) && len(got.SupportedValidations)+len(tt.wantSupportedValidations) > 0 {
+				t.Errorf("SupportedValidations = %v, want %v", got.SupportedValidations, tt.wantSupportedValidations)
+			}
+		})
+	}
+}
+
+func TestLookupMetafieldType_doesNotShareValidations(t *testing.T) {
+	listType, _ := LookupMetafieldType("list.number_integer")
+	baseType, _ := LookupMetafieldType("number_integer")
+	if baseType.SupportsValidation("list.min") {
+		t.Errorf("number_integer supports list.min after looking up %s", listType.Name)
+	}
+}
+
+func TestMetafieldTypeNames(t *testing.T) {
+	names := MetafieldTypeNames()
+	if !slices.IsSorted(names) {
+		t.Error("MetafieldTypeNames() is not sorted")
+	}
+	for _, name := range names {
+		if _, ok := LookupMetafieldType(name); !ok {
+			t.Errorf("LookupMetafieldType(%q) failed for a name of MetafieldTypeNames()", name)
+		}
+	}
+	if !slices.Contains(names, "list.product_reference") || slices.Contains(names, "list.json") {
+		t.Error("MetafieldTypeNames() doesn't match the list variants of the catalog")
+	}
+}
+
+func TestCanMigrateMetafieldType(t *testing.T) {
+	tests := []struct {
+		from string
+		to   string
+		want bool
+	}{
+		{from: "single_line_text_field", to: "single_line_text_field", want: true},
+		{from: "single_line_text_field", to: "multi_line_text_field", want: true},
+		{from: "multi_line_text_field", to: "single_line_text_field", want: false},
+		{from: "product_reference", to: "list.product_reference", want: true},
+		{from: "list.product_reference", to: "product_reference", want: false},
+		{from: "json", to: "list.json", want: false},
+		{from: "single_line_text_field", to: "number_integer", want: false},
+		{from: "unknown", to: "list.unknown", want: false},
+	}
+	for _, tt := range tests {
+		if got := CanMigrateMetafieldType(tt.from, tt.to); got != tt.want {
+			t.Errorf("CanMigrateMetafieldType(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
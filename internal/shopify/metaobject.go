@@ -0,0 +1,185 @@
+package shopify
+
+import (
+	"context"
+)
+
+type Metaobject struct {
+	ID           string                  `json:"id"`
+	Type         string                  `json:"type"`
+	Handle       string                  `json:"handle"`
+	Fields       []*MetaobjectField      `json:"fields"`
+	Capabilities *MetaobjectCapabilities `json:"capabilities"`
+}
+
+type MetaobjectField struct {
+	Key   string  `json:"key"`
+	Value *string `json:"value"`
+}
+
+type MetaobjectCapabilities struct {
+	Publishable *MetaobjectCapabilitiesPublishable `json:"publishable,omitempty"`
+}
+
+type MetaobjectCapabilitiesPublishable struct {
+	Status string `json:"status"`
+}
+
+type MetaobjectHandleInput struct {
+	Type   string `json:"type"`
+	Handle string `json:"handle"`
+}
+
+type MetaobjectCreateInput struct {
+	Type         string                  `json:"type"`
+	Handle       string                  `json:"handle,omitempty"`
+	Fields       []*MetaobjectFieldInput `json:"fields"`
+	Capabilities *MetaobjectCapabilities `json:"capabilities,omitempty"`
+}
+
+type MetaobjectUpsertInput struct {
+	Handle       string                  `json:"handle,omitempty"`
+	Fields       []*MetaobjectFieldInput `json:"fields"`
+	Capabilities *MetaobjectCapabilities `json:"capabilities,omitempty"`
+}
+
+type MetaobjectFieldInput struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+const metaobjectFields = `
+      id
+      type
+      handle
+      fields {
+        key
+        value
+      }
+      capabilities {
+        publishable {
+          status
+        }
+      }
+`
+
+// CreateMetaobject creates a new metaobject. It fails with the TAKEN user error when the handle is already used
+// by another metaobject of the type, unlike UpsertMetaobject which updates the existing one.
+func (c *Client) CreateMetaobject(ctx context.Context, input *MetaobjectCreateInput) (*Metaobject, error) {
+	variables := map[string]interface{}{"metaobject": input}
+	query := `
+mutation CreateMetaobject($metaobject: MetaobjectCreateInput!) {
+  metaobjectCreate(metaobject: $metaobject) {
+    metaobject {` + metaobjectFields + `    }
+    userErrors {
+      field
+      message
+      code
+      elementIndex
+    }
+  }
+}`
+
+	type CreateMetaobjectResponse struct {
+		MetaobjectCreate struct {
+			Metaobject *Metaobject `json:"metaobject"`
+			UserErrors UserErrors  `json:"userErrors"`
+		} `json:"metaobjectCreate"`
+	}
+	var gqlResp CreateMetaobjectResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.MetaobjectCreate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.MetaobjectCreate.Metaobject, nil
+}
+
+// UpsertMetaobject creates or updates the metaobject identified by the given type and handle.
+func (c *Client) UpsertMetaobject(ctx context.Context, handle *MetaobjectHandleInput, input *MetaobjectUpsertInput) (*Metaobject, error) {
+	variables := map[string]interface{}{"handle": handle, "metaobject": input}
+	query := `
+mutation UpsertMetaobject($handle: MetaobjectHandleInput!, $metaobject: MetaobjectUpsertInput!) {
+  metaobjectUpsert(handle: $handle, metaobject: $metaobject) {
+    metaobject {` + metaobjectFields + `    }
+    userErrors {
+      field
+      message
+      code
+      elementIndex
+    }
+  }
+}`
+
+	type UpsertMetaobjectResponse struct {
+		MetaobjectUpsert struct {
+			Metaobject *Metaobject `json:"metaobject"`
+			UserErrors UserErrors  `json:"userErrors"`
+		} `json:"metaobjectUpsert"`
+	}
+	var gqlResp UpsertMetaobjectResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.MetaobjectUpsert.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.MetaobjectUpsert.Metaobject, nil
+}
+
+type GetMetaobjectResponse struct {
+	Metaobject *Metaobject `json:"metaobject"`
+}
+
+func (c *Client) GetMetaobject(ctx context.Context, id string) (*Metaobject, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query metaobject($id: ID!) {
+  metaobject(id: $id) {` + metaobjectFields + `  }
+}
+`
+
+	var gqlResp GetMetaobjectResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if gqlResp.Metaobject == nil {
+		return nil, newNotFoundError("metaobject", id)
+	}
+	return gqlResp.Metaobject, nil
+}
+
+func (c *Client) DeleteMetaobject(ctx context.Context, id string) error {
+	variables := map[string]interface{}{"id": id}
+	query := `
+mutation DeleteMetaobject($id: ID!) {
+  metaobjectDelete(id: $id) {
+    deletedId
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	type DeleteMetaobjectResponse struct {
+		MetaobjectDelete struct {
+			DeletedID  string     `json:"deletedId"`
+			UserErrors UserErrors `json:"userErrors"`
+		} `json:"metaobjectDelete"`
+	}
+	var gqlResp DeleteMetaobjectResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	if err := gqlResp.MetaobjectDelete.UserErrors.Error(); err != nil {
+		return err
+	}
+	return nil
+}
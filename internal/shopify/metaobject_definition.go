@@ -10,14 +10,71 @@ type MetaobjectAccess struct {
 }
 
 type MetaobjectDefinition struct {
-	ID                string                       `json:"id"`
-	Type              string                       `json:"type"`
-	Name              string                       `json:"name"`
-	Description       string                       `json:"description,omitempty"`
-	DisplayNameKey    *string                      `json:"displayNameKey,omitempty"`
-	FieldDefinitions  []*MetaobjectFieldDefinition `json:"fieldDefinitions"`
-	HasThumbnailField bool                         `json:"hasThumbnailField"`
-	Access            *MetaobjectAccess            `json:"access"`
+	ID                string                            `json:"id"`
+	Type              string                            `json:"type"`
+	Name              string                            `json:"name"`
+	Description       string                            `json:"description,omitempty"`
+	DisplayNameKey    *string                           `json:"displayNameKey,omitempty"`
+	FieldDefinitions  []*MetaobjectFieldDefinition      `json:"fieldDefinitions"`
+	HasThumbnailField bool                              `json:"hasThumbnailField"`
+	Access            *MetaobjectAccess                 `json:"access"`
+	Capabilities      *MetaobjectDefinitionCapabilities `json:"capabilities"`
+}
+
+// MetaobjectDefinitionCapabilities are the capabilities of the metaobjects of a definition.
+type MetaobjectDefinitionCapabilities struct {
+	Publishable  MetaobjectDefinitionCapability            `json:"publishable"`
+	Translatable MetaobjectDefinitionCapability            `json:"translatable"`
+	Renderable   MetaobjectDefinitionCapabilityRenderable  `json:"renderable"`
+	OnlineStore  MetaobjectDefinitionCapabilityOnlineStore `json:"onlineStore"`
+}
+
+type MetaobjectDefinitionCapability struct {
+	Enabled bool `json:"enabled"`
+}
+
+type MetaobjectDefinitionCapabilityRenderable struct {
+	Enabled bool                                          `json:"enabled"`
+	Data    *MetaobjectDefinitionCapabilityRenderableData `json:"data"`
+}
+
+// MetaobjectDefinitionCapabilityRenderableData is also used as the input.
+type MetaobjectDefinitionCapabilityRenderableData struct {
+	MetaTitleKey       *string `json:"metaTitleKey,omitempty"`
+	MetaDescriptionKey *string `json:"metaDescriptionKey,omitempty"`
+}
+
+type MetaobjectDefinitionCapabilityOnlineStore struct {
+	Enabled bool                                           `json:"enabled"`
+	Data    *MetaobjectDefinitionCapabilityOnlineStoreData `json:"data"`
+}
+
+type MetaobjectDefinitionCapabilityOnlineStoreData struct {
+	URLHandle          string `json:"urlHandle"`
+	CanCreateRedirects bool   `json:"canCreateRedirects"`
+}
+
+// MetaobjectDefinitionCapabilitiesInput is the input of the capabilities. Capabilities omitted from the input are left unchanged.
+type MetaobjectDefinitionCapabilitiesInput struct {
+	Publishable  *MetaobjectDefinitionCapability                 `json:"publishable,omitempty"`
+	Translatable *MetaobjectDefinitionCapability                 `json:"translatable,omitempty"`
+	Renderable   *MetaobjectDefinitionCapabilityRenderableInput  `json:"renderable,omitempty"`
+	OnlineStore  *MetaobjectDefinitionCapabilityOnlineStoreInput `json:"onlineStore,omitempty"`
+}
+
+type MetaobjectDefinitionCapabilityRenderableInput struct {
+	Enabled bool                                          `json:"enabled"`
+	Data    *MetaobjectDefinitionCapabilityRenderableData `json:"data,omitempty"`
+}
+
+type MetaobjectDefinitionCapabilityOnlineStoreInput struct {
+	Enabled bool                                                `json:"enabled"`
+	Data    *MetaobjectDefinitionCapabilityOnlineStoreDataInput `json:"data,omitempty"`
+}
+
+type MetaobjectDefinitionCapabilityOnlineStoreDataInput struct {
+	URLHandle       string `json:"urlHandle"`
+	CreateRedirects bool   `json:"createRedirects"`
 }
 
 type MetaobjectFieldDefinition struct {
@@ -37,6 +94,7 @@ type MetaobjectDefinitionCreateInput struct {
 	DisplayNameKey   *string                                 `json:"displayNameKey,omitempty"`
 	FieldDefinitions []*MetaobjectFieldDefinitionCreateInput `json:"fieldDefinitions"`
 	Access           *MetaobjectAccess                       `json:"access,omitempty"`
+	Capabilities     *MetaobjectDefinitionCapabilitiesInput  `json:"capabilities,omitempty"`
 }
 
 type MetaobjectFieldDefinitionCreateInput struct {
@@ -78,11 +136,34 @@ mutation CreateMetaobjectDefinition($definition: MetaobjectDefinitionCreateInput
         admin
         storefront
       }
+      capabilities {
+        publishable {
+          enabled
+        }
+        translatable {
+          enabled
+        }
+        renderable {
+          enabled
+          data {
+            metaTitleKey
+            metaDescriptionKey
+          }
+        }
+        onlineStore {
+          enabled
+          data {
+            urlHandle
+            canCreateRedirects
+          }
+        }
+      }
     }
     userErrors {
       field
       message
       code
+      elementIndex
     }
   }
 }`
@@ -94,7 +175,7 @@ mutation CreateMetaobjectDefinition($definition: MetaobjectDefinitionCreateInput
 		} `json:"metaobjectDefinitionCreate"`
 	}
 	var gqlResp CreateMetaobjectDefinitionResponse
-	err := c.shopifyClient.GraphQL.Query(ctx, query, variables, &gqlResp)
+	err := c.query(ctx, query, variables, &gqlResp)
 	if err != nil {
 		return nil, err
 	}
@@ -137,24 +218,205 @@ query metaobjectDefinition($id: ID!) {
       admin
       storefront
     }
+    capabilities {
+      publishable {
+        enabled
+      }
+      translatable {
+        enabled
+      }
+      renderable {
+        enabled
+        data {
+          metaTitleKey
+          metaDescriptionKey
+        }
+      }
+      onlineStore {
+        enabled
+        data {
+          urlHandle
+          canCreateRedirects
+        }
+      }
+    }
   }
 }
 `
 
 	var gqlResp GetMetaobjectDefinitionResponse
-	err := c.shopifyClient.GraphQL.Query(ctx, query, variables, &gqlResp)
+	err := c.query(ctx, query, variables, &gqlResp)
 	if err != nil {
 		return nil, err
 	}
+	if gqlResp.MetaobjectDefinition == nil {
+		return nil, newNotFoundError("metaobject definition", id)
+	}
 	return gqlResp.MetaobjectDefinition, nil
 }
 
+type GetMetaobjectDefinitionByTypeResponse struct {
+	MetaobjectDefinitionByType *MetaobjectDefinition `json:"metaobjectDefinitionByType"`
+}
+
+func (c *Client) GetMetaobjectDefinitionByType(ctx context.Context, definitionType string) (*MetaobjectDefinition, error) {
+	variables := map[string]interface{}{"type": definitionType}
+	query := `
+query metaobjectDefinitionByType($type: String!) {
+  metaobjectDefinitionByType(type: $type) {
+    id
+    type
+    name
+    description
+    displayNameKey
+    fieldDefinitions {
+      key
+      name
+      description
+      type {
+        category
+        name
+      }
+      required
+      validations {
+        name
+        value
+      }
+    }
+    hasThumbnailField
+    access {
+      admin
+      storefront
+    }
+    capabilities {
+      publishable {
+        enabled
+      }
+      translatable {
+        enabled
+      }
+      renderable {
+        enabled
+        data {
+          metaTitleKey
+          metaDescriptionKey
+        }
+      }
+      onlineStore {
+        enabled
+        data {
+          urlHandle
+          canCreateRedirects
+        }
+      }
+    }
+  }
+}
+`
+
+	var gqlResp GetMetaobjectDefinitionByTypeResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if gqlResp.MetaobjectDefinitionByType == nil {
+		return nil, newNotFoundError("metaobject definition of type", definitionType)
+	}
+	return gqlResp.MetaobjectDefinitionByType, nil
+}
+
+type ListMetaobjectDefinitionsResponse struct {
+	MetaobjectDefinitions struct {
+		Nodes    []*MetaobjectDefinition `json:"nodes"`
+		PageInfo PageInfo                `json:"pageInfo"`
+	} `json:"metaobjectDefinitions"`
+}
+
+// ListMetaobjectDefinitions returns all metaobject definitions of the shop.
+func (c *Client) ListMetaobjectDefinitions(ctx context.Context) ([]*MetaobjectDefinition, error) {
+	query := `
+query metaobjectDefinitions($after: String) {
+  metaobjectDefinitions(first: 50, after: $after) {
+    nodes {
+      id
+      type
+      name
+      description
+      displayNameKey
+      fieldDefinitions {
+        key
+        name
+        description
+        type {
+          category
+          name
+        }
+        required
+        validations {
+          name
+          value
+        }
+      }
+      hasThumbnailField
+      access {
+        admin
+        storefront
+      }
+      capabilities {
+        publishable {
+          enabled
+        }
+        translatable {
+          enabled
+        }
+        renderable {
+          enabled
+          data {
+            metaTitleKey
+            metaDescriptionKey
+          }
+        }
+        onlineStore {
+          enabled
+          data {
+            urlHandle
+            canCreateRedirects
+          }
+        }
+      }
+    }
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+  }
+}
+`
+
+	var definitions []*MetaobjectDefinition
+	var after *string
+	for {
+		variables := map[string]interface{}{"after": after}
+		var gqlResp ListMetaobjectDefinitionsResponse
+		err := c.query(ctx, query, variables, &gqlResp)
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, gqlResp.MetaobjectDefinitions.Nodes...)
+		if !gqlResp.MetaobjectDefinitions.PageInfo.HasNextPage {
+			return definitions, nil
+		}
+		after = gqlResp.MetaobjectDefinitions.PageInfo.EndCursor
+	}
+}
+
 type MetaobjectDefinitionUpdateInput struct {
 	Name             string                                     `json:"name"`
 	Description      *string                                    `json:"description,omitempty"`
 	DisplayNameKey   *string                                    `json:"displayNameKey,omitempty"`
 	FieldDefinitions []*MetaobjectFieldDefinitionOperationInput `json:"fieldDefinitions"`
 	Access           *MetaobjectAccess                          `json:"access,omitempty"`
+	Capabilities     *MetaobjectDefinitionCapabilitiesInput     `json:"capabilities,omitempty"`
 }
 
 type MetaobjectFieldDefinitionOperationInput struct {
@@ -164,9 +426,11 @@ type MetaobjectFieldDefinitionOperationInput struct {
 }
 
 type MetaobjectFieldDefinitionUpdateInput struct {
-	Key         string                           `json:"key"`
-	Name        *string                          `json:"name"`
-	Description *string                          `json:"description"`
+	Key         string  `json:"key"`
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+	// Type is set only to migrate the field to a compatible type. See CanMigrateMetafieldType.
+	Type        *string                          `json:"type,omitempty"`
 	Required    bool                             `json:"required"`
 	Validations []*MetafieldDefinitionValidation `json:"validations"`
 }
@@ -205,11 +469,34 @@ mutation UpdateMetaobjectDefinition($id: ID!, $definition: MetaobjectDefinitionU
         admin
         storefront
       }
+      capabilities {
+        publishable {
+          enabled
+        }
+        translatable {
+          enabled
+        }
+        renderable {
+          enabled
+          data {
+            metaTitleKey
+            metaDescriptionKey
+          }
+        }
+        onlineStore {
+          enabled
+          data {
+            urlHandle
+            canCreateRedirects
+          }
+        }
+      }
     }
     userErrors {
       field
       message
       code
+      elementIndex
     }
   }
 }`
@@ -222,7 +509,7 @@ mutation UpdateMetaobjectDefinition($id: ID!, $definition: MetaobjectDefinitionU
 	}
 
 	var gqlResp UpdateMetaobjectDefinitionResponse
-	err := c.shopifyClient.GraphQL.Query(ctx, query, variables, &gqlResp)
+	err := c.query(ctx, query, variables, &gqlResp)
 	if err != nil {
 		return nil, err
 	}
@@ -253,7 +540,7 @@ mutation DeleteMetaobjectDefinition($id: ID!) {
 		} `json:"metaobjectDefinitionDelete"`
 	}
 	var gqlResp DeleteMetaobjectDefinitionResponse
-	err := c.shopifyClient.GraphQL.Query(ctx, query, variables, &gqlResp)
+	err := c.query(ctx, query, variables, &gqlResp)
 	if err != nil {
 		return err
 	}
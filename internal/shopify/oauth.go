@@ -0,0 +1,165 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+// accessTokenHeader is the header to authenticate the Admin API requests with.
+const accessTokenHeader = "X-Shopify-Access-Token"
+
+// tokenRefreshMargin is how long before the expiry an access token is refreshed,
+// so that a token doesn't expire while a request is in flight.
+const tokenRefreshMargin = 5 * time.Minute
+
+// ClientCredentialsTokenSource obtains the Admin API access tokens of an app with the client credentials grant,
+// and refreshes them when they are about to expire.
+type ClientCredentialsTokenSource struct {
+	httpClient   *http.Client
+	tokenURL     string
+	clientID     string
+	clientSecret string
+
+	mu          sync.Mutex
+	accessToken string
+	// expiresAt is zero if the token doesn't expire.
+	expiresAt time.Time
+}
+
+// NewClientCredentialsTokenSource returns a token source of the shop for the app with the API key and the API secret key.
+// The tokens are requested with the given HTTP client, which must not authenticate with the tokens of this source.
+func NewClientCredentialsTokenSource(httpClient *http.Client, shop string, apiKey string, apiSecretKey string) *ClientCredentialsTokenSource {
+	return &ClientCredentialsTokenSource{
+		httpClient:   httpClient,
+		tokenURL:     goshopify.ShopBaseUrl(shop) + "/admin/oauth/access_token",
+		clientID:     apiKey,
+		clientSecret: apiSecretKey,
+	}
+}
+
+type accessTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	Scope       string `json:"scope"`
+	// ExpiresIn is the lifetime of the token in seconds. The token doesn't expire if it's zero.
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Token returns the current access token, requesting a new one if there is no valid token.
+func (s *ClientCredentialsTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.accessToken != "" && (s.expiresAt.IsZero() || time.Now().Add(tokenRefreshMargin).Before(s.expiresAt)) {
+		return s.accessToken, nil
+	}
+
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {s.clientID},
+		"client_secret": {s.clientSecret},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	requestedAt := time.Now()
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request access token: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("read access token response: %w", err)
+	}
+
+	var tokenResp accessTokenResponse
+	if err := json.Unmarshal(body, &tokenResp); err != nil && resp.StatusCode == http.StatusOK {
+		return "", fmt.Errorf("decode access token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK || tokenResp.AccessToken == "" {
+		if tokenResp.Error != "" {
+			return "", fmt.Errorf("request access token: %s (%s): %s", resp.Status, tokenResp.Error, tokenResp.ErrorDescription)
+		}
+		return "", fmt.Errorf("request access token: %s", resp.Status)
+	}
+
+	s.accessToken = tokenResp.AccessToken
+	s.expiresAt = time.Time{}
+	if tokenResp.ExpiresIn > 0 {
+		s.expiresAt = requestedAt.Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	}
+	return s.accessToken, nil
+}
+
+// invalidate discards the token if it's still the current one, so that the next call of Token requests a new one.
+func (s *ClientCredentialsTokenSource) invalidate(accessToken string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.accessToken == accessToken {
+		s.accessToken = ""
+	}
+}
+
+type accessTokenTransport struct {
+	source    *ClientCredentialsTokenSource
+	transport http.RoundTripper
+}
+
+// NewAccessTokenTransport returns a transport authenticating the requests with the tokens of the source.
+// A request rejected with 401 is retried once with a new token, in case the token was revoked before the expiry.
+func NewAccessTokenTransport(source *ClientCredentialsTokenSource, t http.RoundTripper) http.RoundTripper {
+	return &accessTokenTransport{
+		source:    source,
+		transport: t,
+	}
+}
+
+func (t *accessTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	accessToken, err := t.source.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.transport.RoundTrip(withAccessToken(req, accessToken))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || (req.Body != nil && req.GetBody == nil) {
+		return resp, err
+	}
+
+	t.source.invalidate(accessToken)
+	newAccessToken, err := t.source.Token(req.Context())
+	if err != nil || newAccessToken == accessToken {
+		return resp, nil
+	}
+	retryReq := withAccessToken(req, newAccessToken)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retryReq.Body = body
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+	return t.transport.RoundTrip(retryReq)
+}
+
+// withAccessToken returns a copy of the request with the access token, since a RoundTripper must not modify the request.
+func withAccessToken(req *http.Request, accessToken string) *http.Request {
+	req = req.Clone(req.Context())
+	req.Header.Set(accessTokenHeader, accessToken)
+	return req
+}
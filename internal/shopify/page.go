@@ -1,7 +1,58 @@
 package shopify
 
-import goshopify "github.com/bold-commerce/go-shopify/v4"
+import (
+	"context"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
 
 func (c *Client) Page() goshopify.PageService {
 	return c.shopifyClient.Page
 }
+
+// GetPage gets the page by its numeric ID. ErrNotFound is returned when the page doesn't exist.
+func (c *Client) GetPage(ctx context.Context, id uint64) (*goshopify.Page, error) {
+	page, err := c.shopifyClient.Page.Get(ctx, id, nil)
+	if err != nil {
+		return nil, convertRESTNotFoundError(err, "page", id)
+	}
+	return page, nil
+}
+
+// FindPageByHandle looks up the page by its handle.
+// nil is returned when no page matches.
+func (c *Client) FindPageByHandle(ctx context.Context, handle string) (*goshopify.Page, error) {
+	options := struct {
+		Handle string `url:"handle"`
+		Limit  int    `url:"limit"`
+	}{Handle: handle, Limit: 1}
+	pages, err := c.shopifyClient.Page.List(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	if len(pages) == 0 {
+		return nil, nil
+	}
+	return &pages[0], nil
+}
+
+// ListPages returns all pages of the shop in the order of their IDs.
+func (c *Client) ListPages(ctx context.Context) ([]goshopify.Page, error) {
+	var pages []goshopify.Page
+	var sinceID uint64
+	for {
+		options := struct {
+			Limit   int    `url:"limit"`
+			SinceID uint64 `url:"since_id,omitempty"`
+		}{Limit: 250, SinceID: sinceID}
+		page, err := c.shopifyClient.Page.List(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, page...)
+		if len(page) < options.Limit {
+			return pages, nil
+		}
+		sinceID = page[len(page)-1].Id
+	}
+}
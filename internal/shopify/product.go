@@ -0,0 +1,258 @@
+package shopify
+
+import (
+	"context"
+)
+
+type Product struct {
+	ID          string                    `json:"id"`
+	Title       string                    `json:"title"`
+	Handle      string                    `json:"handle"`
+	Status      string                    `json:"status"`
+	Vendor      string                    `json:"vendor"`
+	ProductType string                    `json:"productType"`
+	Tags        []string                  `json:"tags"`
+	Options     []*ProductOption          `json:"options"`
+	Variants    *ProductVariantConnection `json:"variants"`
+}
+
+type ProductOption struct {
+	Name         string                `json:"name"`
+	Position     int                   `json:"position"`
+	OptionValues []*ProductOptionValue `json:"optionValues"`
+}
+
+type ProductOptionValue struct {
+	Name string `json:"name"`
+}
+
+type ProductVariantConnection struct {
+	Nodes    []*ProductVariant `json:"nodes"`
+	PageInfo PageInfo          `json:"pageInfo"`
+}
+
+type ProductVariant struct {
+	ID              string                   `json:"id"`
+	Title           string                   `json:"title"`
+	SKU             string                   `json:"sku"`
+	Price           string                   `json:"price"`
+	CompareAtPrice  *string                  `json:"compareAtPrice"`
+	Barcode         *string                  `json:"barcode"`
+	Taxable         bool                     `json:"taxable"`
+	SelectedOptions []*SelectedProductOption `json:"selectedOptions"`
+}
+
+type SelectedProductOption struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type ProductSetInput struct {
+	ID             *string                   `json:"id,omitempty"`
+	Title          string                    `json:"title"`
+	Handle         *string                   `json:"handle,omitempty"`
+	Status         string                    `json:"status,omitempty"`
+	Vendor         *string                   `json:"vendor,omitempty"`
+	ProductType    *string                   `json:"productType,omitempty"`
+	Tags           []string                  `json:"tags"`
+	ProductOptions []*OptionSetInput         `json:"productOptions,omitempty"`
+	Variants       []*ProductVariantSetInput `json:"variants,omitempty"`
+}
+
+type OptionSetInput struct {
+	Name     string                 `json:"name"`
+	Position int                    `json:"position"`
+	Values   []*OptionValueSetInput `json:"values"`
+}
+
+type OptionValueSetInput struct {
+	Name string `json:"name"`
+}
+
+type ProductVariantSetInput struct {
+	ID             *string                    `json:"id,omitempty"`
+	OptionValues   []*VariantOptionValueInput `json:"optionValues"`
+	Price          *string                    `json:"price,omitempty"`
+	CompareAtPrice *string                    `json:"compareAtPrice,omitempty"`
+	SKU            *string                    `json:"sku,omitempty"`
+	Barcode        *string                    `json:"barcode,omitempty"`
+	Taxable        *bool                      `json:"taxable,omitempty"`
+}
+
+type VariantOptionValueInput struct {
+	OptionName string `json:"optionName"`
+	Name       string `json:"name"`
+}
+
+const productFields = `
+      id
+      title
+      handle
+      status
+      vendor
+      productType
+      tags
+      options {
+        name
+        position
+        optionValues {
+          name
+        }
+      }
+      variants(first: 250) {` + productVariantConnectionFields + `      }
+`
+
+const productVariantConnectionFields = `
+        nodes {
+          id
+          title
+          sku
+          price
+          compareAtPrice
+          barcode
+          taxable
+          selectedOptions {
+            name
+            value
+          }
+        }
+        pageInfo {
+          hasNextPage
+          endCursor
+        }
+`
+
+type SetProductResponse struct {
+	ProductSet struct {
+		Product    *Product   `json:"product"`
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"productSet"`
+}
+
+// CreateProduct creates a product with the given options and variants.
+// The input must not have ID set.
+func (c *Client) CreateProduct(ctx context.Context, input *ProductSetInput) (*Product, error) {
+	return c.setProduct(ctx, input)
+}
+
+// UpdateProduct overwrites the product identified by input.ID.
+// Variants which are not included in the input are deleted.
+func (c *Client) UpdateProduct(ctx context.Context, input *ProductSetInput) (*Product, error) {
+	return c.setProduct(ctx, input)
+}
+
+func (c *Client) setProduct(ctx context.Context, input *ProductSetInput) (*Product, error) {
+	variables := map[string]interface{}{"input": input}
+	query := `
+mutation SetProduct($input: ProductSetInput!) {
+  productSet(input: $input, synchronous: true) {
+    product {` + productFields + `    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp SetProductResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.ProductSet.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	product := gqlResp.ProductSet.Product
+	if err := c.fetchRemainingProductVariants(ctx, product); err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+type GetProductResponse struct {
+	Product *Product `json:"product"`
+}
+
+func (c *Client) GetProduct(ctx context.Context, id string) (*Product, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query product($id: ID!) {
+  product(id: $id) {` + productFields + `  }
+}
+`
+
+	var gqlResp GetProductResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if gqlResp.Product == nil {
+		return nil, newNotFoundError("product", id)
+	}
+	if err := c.fetchRemainingProductVariants(ctx, gqlResp.Product); err != nil {
+		return nil, err
+	}
+	return gqlResp.Product, nil
+}
+
+// fetchRemainingProductVariants appends the variants after the first page to the product,
+// since a page of the connection is limited to 250 nodes.
+func (c *Client) fetchRemainingProductVariants(ctx context.Context, product *Product) error {
+	if product == nil || product.Variants == nil {
+		return nil
+	}
+	query := `
+query productVariants($id: ID!, $after: String) {
+  product(id: $id) {
+    variants(first: 250, after: $after) {` + productVariantConnectionFields + `    }
+  }
+}
+`
+
+	for product.Variants.PageInfo.HasNextPage {
+		variables := map[string]interface{}{"id": product.ID, "after": product.Variants.PageInfo.EndCursor}
+		var gqlResp GetProductResponse
+		err := c.query(ctx, query, variables, &gqlResp)
+		if err != nil {
+			return err
+		}
+		if gqlResp.Product == nil || gqlResp.Product.Variants == nil {
+			return newNotFoundError("product", product.ID)
+		}
+		product.Variants.Nodes = append(product.Variants.Nodes, gqlResp.Product.Variants.Nodes...)
+		product.Variants.PageInfo = gqlResp.Product.Variants.PageInfo
+	}
+	return nil
+}
+
+type DeleteProductResponse struct {
+	ProductDelete struct {
+		DeletedProductID string     `json:"deletedProductId"`
+		UserErrors       UserErrors `json:"userErrors"`
+	} `json:"productDelete"`
+}
+
+func (c *Client) DeleteProduct(ctx context.Context, id string) error {
+	variables := map[string]interface{}{"input": map[string]interface{}{"id": id}}
+	query := `
+mutation DeleteProduct($input: ProductDeleteInput!) {
+  productDelete(input: $input) {
+    deletedProductId
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+	var gqlResp DeleteProductResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	if err := gqlResp.ProductDelete.UserErrors.Error(); err != nil {
+		return err
+	}
+	return nil
+}
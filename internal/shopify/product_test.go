@@ -0,0 +1,87 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_productVariantPagination(t *testing.T) {
+	const firstPage = `{"id": "gid://shopify/Product/1", "title": "Shirt", "variants": {
+  "nodes": [{"id": "gid://shopify/ProductVariant/1"}, {"id": "gid://shopify/ProductVariant/2"}],
+  "pageInfo": {"hasNextPage": true, "endCursor": "cursor-2"}
+}}`
+	nextPages := map[string]string{
+		"cursor-2": `{"nodes": [{"id": "gid://shopify/ProductVariant/3"}], "pageInfo": {"hasNextPage": true, "endCursor": "cursor-3"}}`,
+		"cursor-3": `{"nodes": [{"id": "gid://shopify/ProductVariant/4"}], "pageInfo": {"hasNextPage": false, "endCursor": "cursor-4"}}`,
+	}
+
+	tests := []struct {
+		name          string
+		firstResponse string
+		call          func(ctx context.Context, client *Client) (*Product, error)
+	}{
+		{
+			name:          "GetProduct",
+			firstResponse: `{"data": {"product": ` + firstPage + `}}`,
+			call: func(ctx context.Context, client *Client) (*Product, error) {
+				return client.GetProduct(ctx, "gid://shopify/Product/1")
+			},
+		},
+		{
+			name:          "UpdateProduct",
+			firstResponse: `{"data": {"productSet": {"product": ` + firstPage + `, "userErrors": []}}}`,
+			call: func(ctx context.Context, client *Client) (*Product, error) {
+				id := "gid://shopify/Product/1"
+				return client.UpdateProduct(ctx, &ProductSetInput{ID: &id, Title: "Shirt"})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var afters []string
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				var req struct {
+					Query     string `json:"query"`
+					Variables struct {
+						ID    string  `json:"id"`
+						After *string `json:"after"`
+					} `json:"variables"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Error(err)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if !strings.Contains(req.Query, "query productVariants") {
+					fmt.Fprint(w, tt.firstResponse)
+					return
+				}
+				if req.Variables.ID != "gid://shopify/Product/1" || req.Variables.After == nil {
+					t.Errorf("unexpected variables: id = %q, after = %v", req.Variables.ID, req.Variables.After)
+					return
+				}
+				afters = append(afters, *req.Variables.After)
+				fmt.Fprintf(w, `{"data": {"product": {"variants": %s}}}`, nextPages[*req.Variables.After])
+			})
+
+			product, err := tt.call(context.Background(), client)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Join(afters, ",") != "cursor-2,cursor-3" {
+				t.Errorf("fetched the pages after %v, want [cursor-2 cursor-3]", afters)
+			}
+			var ids []string
+			for _, variant := range product.Variants.Nodes {
+				ids = append(ids, variant.ID)
+			}
+			want := "gid://shopify/ProductVariant/1,gid://shopify/ProductVariant/2,gid://shopify/ProductVariant/3,gid://shopify/ProductVariant/4"
+			if strings.Join(ids, ",") != want {
+				t.Errorf("variants = %v, want %s", ids, want)
+			}
+		})
+	}
+}
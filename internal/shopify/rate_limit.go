@@ -0,0 +1,120 @@
+package shopify
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// defaultQueryCost is the estimated cost of a query which has never been executed.
+// Shopify charges 10 points for a mutation and most of the queries in this package cost less.
+const defaultQueryCost = 10
+
+// ThrottleStatus is the status of the shop's GraphQL rate limit points,
+// reported in `extensions.cost.throttleStatus` of every GraphQL response.
+type ThrottleStatus struct {
+	MaximumAvailable   float64 `json:"maximumAvailable"`
+	CurrentlyAvailable float64 `json:"currentlyAvailable"`
+	RestoreRate        float64 `json:"restoreRate"`
+}
+
+// QueryCost is the cost of a GraphQL query reported in `extensions.cost`.
+type QueryCost struct {
+	RequestedQueryCost float64        `json:"requestedQueryCost"`
+	ActualQueryCost    *float64       `json:"actualQueryCost"`
+	ThrottleStatus     ThrottleStatus `json:"throttleStatus"`
+}
+
+// rateLimiter tracks the leaky bucket of the GraphQL Admin API and blocks callers
+// until enough points are restored for their queries.
+type rateLimiter struct {
+	mu sync.Mutex
+	// status is the last throttle status reported by Shopify, nil until the first response.
+	status    *ThrottleStatus
+	updatedAt time.Time
+	// queryCosts are the last requested costs keyed by query, used to estimate the cost before sending a query.
+	queryCosts map[string]float64
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		queryCosts: map[string]float64{},
+	}
+}
+
+// wait blocks until the estimated cost of the query is available, and then reserves it.
+func (l *rateLimiter) wait(ctx context.Context, query string) error {
+	for {
+		delay := l.reserve(query)
+		if delay == 0 {
+			return nil
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve reserves the estimated cost of the query if it's available.
+// Otherwise, it returns the duration until the cost is expected to be restored.
+func (l *rateLimiter) reserve(query string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.status == nil || l.status.RestoreRate <= 0 {
+		return 0
+	}
+
+	cost, ok := l.queryCosts[query]
+	if !ok {
+		cost = defaultQueryCost
+	}
+	// A query which is more expensive than the bucket size can only be sent with the full bucket.
+	cost = math.Min(cost, l.status.MaximumAvailable)
+
+	now := time.Now()
+	available := math.Min(
+		l.status.MaximumAvailable,
+		l.status.CurrentlyAvailable+now.Sub(l.updatedAt).Seconds()*l.status.RestoreRate,
+	)
+	if available < cost {
+		return time.Duration((cost - available) / l.status.RestoreRate * float64(time.Second))
+	}
+
+	l.status.CurrentlyAvailable = available - cost
+	l.updatedAt = now
+	return 0
+}
+
+// hasStatus reports whether the limiter knows the throttle status to delay queries until the points are restored.
+func (l *rateLimiter) hasStatus() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.status != nil && l.status.RestoreRate > 0
+}
+
+// update records the cost reported by Shopify.
+func (l *rateLimiter) update(query string, cost *QueryCost) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	status := cost.ThrottleStatus
+	l.status = &status
+	l.updatedAt = time.Now()
+	if cost.RequestedQueryCost > 0 {
+		l.queryCosts[query] = cost.RequestedQueryCost
+	}
+}
+
+// sleep pauses the current goroutine for the duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,154 @@
+package shopify
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_reserve(t *testing.T) {
+	const query = "query { shop { name } }"
+	tests := []struct {
+		name       string
+		status     *ThrottleStatus
+		elapsed    time.Duration
+		queryCosts map[string]float64
+		// wantDelay is compared with a tolerance since time passes during the test.
+		wantDelay     time.Duration
+		wantAvailable float64
+	}{
+		{
+			name:      "no status",
+			wantDelay: 0,
+		},
+		{
+			name:      "no restore rate",
+			status:    &ThrottleStatus{MaximumAvailable: 1000, CurrentlyAvailable: 0, RestoreRate: 0},
+			wantDelay: 0,
+		},
+		{
+			name:          "reserves the default cost",
+			status:        &ThrottleStatus{MaximumAvailable: 1000, CurrentlyAvailable: 100, RestoreRate: 50},
+			wantDelay:     0,
+			wantAvailable: 100 - defaultQueryCost,
+		},
+		{
+			name:          "reserves the last requested cost",
+			status:        &ThrottleStatus{MaximumAvailable: 1000, CurrentlyAvailable: 100, RestoreRate: 50},
+			queryCosts:    map[string]float64{query: 60},
+			wantDelay:     0,
+			wantAvailable: 40,
+		},
+		{
+			name:          "adds the points restored since the update",
+			status:        &ThrottleStatus{MaximumAvailable: 1000, CurrentlyAvailable: 0, RestoreRate: 50},
+			elapsed:       time.Second,
+			queryCosts:    map[string]float64{query: 30},
+			wantDelay:     0,
+			wantAvailable: 20,
+		},
+		{
+			name:          "restores up to the maximum",
+			status:        &ThrottleStatus{MaximumAvailable: 100, CurrentlyAvailable: 90, RestoreRate: 50},
+			elapsed:       time.Minute,
+			wantDelay:     0,
+			wantAvailable: 100 - defaultQueryCost,
+		},
+		{
+			name:       "delays until the cost is restored",
+			status:     &ThrottleStatus{MaximumAvailable: 1000, CurrentlyAvailable: 10, RestoreRate: 50},
+			queryCosts: map[string]float64{query: 110},
+			// 100 points are restored in 2s.
+			wantDelay:     2 * time.Second,
+			wantAvailable: 10,
+		},
+		{
+			name:       "caps the cost at the bucket size",
+			status:     &ThrottleStatus{MaximumAvailable: 100, CurrentlyAvailable: 50, RestoreRate: 50},
+			queryCosts: map[string]float64{query: 500},
+			// The full bucket of 100 points is available in 1s.
+			wantDelay:     time.Second,
+			wantAvailable: 50,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newRateLimiter()
+			if tt.status != nil {
+				status := *tt.status
+				l.status = &status
+				l.updatedAt = time.Now().Add(-tt.elapsed)
+			}
+			for q, cost := range tt.queryCosts {
+				l.queryCosts[q] = cost
+			}
+
+			delay := l.reserve(query)
+			if diff := tt.wantDelay - delay; diff < 0 || diff > 50*time.Millisecond {
+				t.Errorf("reserve() = %s, want %s", delay, tt.wantDelay)
+			}
+			if l.status == nil {
+				return
+			}
+			// Only the reserved cost is deducted, and nothing is reserved when delayed.
+			if diff := l.status.CurrentlyAvailable - tt.wantAvailable; diff < -1 || diff > 1 {
+				t.Errorf("reserve() left %v points, want %v", l.status.CurrentlyAvailable, tt.wantAvailable)
+			}
+		})
+	}
+}
+
+func TestRateLimiter_update(t *testing.T) {
+	const query = "query { shop { name } }"
+	l := newRateLimiter()
+	if l.hasStatus() {
+		t.Fatal("hasStatus() = true before the first update")
+	}
+
+	l.update(query, &QueryCost{
+		RequestedQueryCost: 42,
+		ThrottleStatus:     ThrottleStatus{MaximumAvailable: 1000, CurrentlyAvailable: 500, RestoreRate: 50},
+	})
+	if !l.hasStatus() {
+		t.Error("hasStatus() = false after the update")
+	}
+	if got := l.queryCosts[query]; got != 42 {
+		t.Errorf("queryCosts[query] = %v, want 42", got)
+	}
+
+	// A zero requested cost doesn't overwrite the known cost.
+	l.update(query, &QueryCost{ThrottleStatus: ThrottleStatus{MaximumAvailable: 1000, CurrentlyAvailable: 500}})
+	if got := l.queryCosts[query]; got != 42 {
+		t.Errorf("queryCosts[query] = %v, want 42", got)
+	}
+	if l.hasStatus() {
+		t.Error("hasStatus() = true without the restore rate")
+	}
+}
+
+func TestRateLimiter_wait(t *testing.T) {
+	const query = "query { shop { name } }"
+	l := newRateLimiter()
+	l.update(query, &QueryCost{
+		RequestedQueryCost: 20,
+		ThrottleStatus:     ThrottleStatus{MaximumAvailable: 1000, CurrentlyAvailable: 0, RestoreRate: 1000},
+	})
+
+	start := time.Now()
+	if err := l.wait(context.Background(), query); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("wait() returned after %s, want after the 20 points are restored in 20ms", elapsed)
+	}
+
+	l.update(query, &QueryCost{
+		RequestedQueryCost: 20,
+		ThrottleStatus:     ThrottleStatus{MaximumAvailable: 1000, CurrentlyAvailable: 0, RestoreRate: 1},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.wait(ctx, query); err == nil {
+		t.Error("wait() error = nil, want the context error")
+	}
+}
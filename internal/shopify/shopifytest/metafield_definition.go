@@ -0,0 +1,267 @@
+package shopifytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+)
+
+// metafieldDefinitionType returns the type of the name if it's in the catalog of the shopify package.
+func metafieldDefinitionType(name string) (*shopify.MetafieldDefinitionType, bool) {
+	info, ok := shopify.LookupMetafieldType(name)
+	if !ok {
+		return nil, false
+	}
+	return &shopify.MetafieldDefinitionType{Category: info.Category, Name: info.Name}, true
+}
+
+var metafieldKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+func (s *Server) findMetafieldDefinition(ownerType, namespace, key string) *shopify.MetafieldDefinition {
+	for _, definition := range s.metafieldDefinitions {
+		if definition.OwnerType == ownerType && definition.Namespace == namespace && definition.Key == key {
+			return definition
+		}
+	}
+	return nil
+}
+
+func (s *Server) setMetafieldDefinitionPin(definition *shopify.MetafieldDefinition, pin bool) {
+	if pin == (definition.PinnedPosition != nil) {
+		return
+	}
+	if !pin {
+		definition.PinnedPosition = nil
+		return
+	}
+	position := 0
+	for _, d := range s.metafieldDefinitions {
+		if d.OwnerType == definition.OwnerType && d.PinnedPosition != nil && *d.PinnedPosition > position {
+			position = *d.PinnedPosition
+		}
+	}
+	position++
+	definition.PinnedPosition = &position
+}
+
+func (s *Server) createMetafieldDefinition(query string, variables json.RawMessage) (interface{}, error) {
+	var vars struct {
+		Definition shopify.MetafieldDefinitionInput `json:"definition"`
+	}
+	if err := json.Unmarshal(variables, &vars); err != nil {
+		return nil, err
+	}
+	input := vars.Definition
+
+	var errs []shopify.UserError
+	if input.Name == "" {
+		errs = append(errs, userError(shopify.UserErrorCodeBlank, "Name can't be blank", "definition", "name"))
+	}
+	if len(input.Namespace) < 3 || len(input.Namespace) > 255 {
+		errs = append(errs, userError(shopify.UserErrorCodeInvalid, "Namespace must be between 3 and 255 characters", "definition", "namespace"))
+	} else if input.Namespace == "shopify" || strings.HasPrefix(input.Namespace, "shopify--") {
+		errs = append(errs, userError(shopify.UserErrorCodeReservedNamespaceKey, fmt.Sprintf("Namespace %s is reserved", input.Namespace), "definition", "namespace"))
+	}
+	if len(input.Key) < 2 || len(input.Key) > 64 || !metafieldKeyRegex.MatchString(input.Key) {
+		errs = append(errs, userError(shopify.UserErrorCodeInvalid, "Key must be 2-64 characters long and only contain alphanumeric, hyphen, and underscore characters", "definition", "key"))
+	} else if s.findMetafieldDefinition(input.OwnerType, input.Namespace, input.Key) != nil {
+		errs = append(errs, userError(shopify.UserErrorCodeTaken, fmt.Sprintf("Key is in use for %s metafields on the '%s' namespace.", strings.ToLower(input.OwnerType), input.Namespace), "definition", "key"))
+	}
+	definitionType, ok := metafieldDefinitionType(input.Type)
+	if !ok {
+		errs = append(errs, userError("INCLUSION", fmt.Sprintf("Type name %s is not a valid type", input.Type), "definition", "type"))
+	}
+	errs = append(errs, validateMetafieldAccess(input.Namespace, input.Access)...)
+
+	var createdDefinition *shopify.MetafieldDefinition
+	if len(errs) == 0 {
+		createdDefinition = &shopify.MetafieldDefinition{
+			ID:          fmt.Sprintf("gid://shopify/MetafieldDefinition/%d", s.nextID()),
+			Name:        input.Name,
+			Description: input.Description,
+			OwnerType:   input.OwnerType,
+			Namespace:   input.Namespace,
+			Key:         input.Key,
+			Type:        definitionType,
+			Validations: validations(input.Validations),
+			Access: &shopify.MetafieldAccess{
+				Admin:           "PUBLIC_READ_WRITE",
+				Storefront:      "NONE",
+				CustomerAccount: "NONE",
+			},
+			Capabilities: &shopify.MetafieldCapabilities{
+				AdminFilterable:          &shopify.MetafieldCapability{},
+				SmartCollectionCondition: &shopify.MetafieldCapability{},
+			},
+		}
+		updateMetafieldAccess(createdDefinition.Access, input.Access)
+		updateMetafieldCapabilities(createdDefinition.Capabilities, input.Capabilities)
+		s.setMetafieldDefinitionPin(createdDefinition, input.Pin)
+		s.metafieldDefinitions = append(s.metafieldDefinitions, createdDefinition)
+	}
+	return map[string]interface{}{
+		"metafieldDefinitionCreate": map[string]interface{}{
+			"createdDefinition": createdDefinition,
+			"userErrors":        userErrors(errs),
+		},
+	}, nil
+}
+
+func (s *Server) getMetafieldDefinition(query string, variables json.RawMessage) (interface{}, error) {
+	var vars struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(variables, &vars); err != nil {
+		return nil, err
+	}
+	var metafieldDefinition *shopify.MetafieldDefinition
+	for _, definition := range s.metafieldDefinitions {
+		if definition.ID == vars.ID {
+			metafieldDefinition = definition
+		}
+	}
+	return map[string]interface{}{"metafieldDefinition": metafieldDefinition}, nil
+}
+
+func (s *Server) listMetafieldDefinitions(query string, variables json.RawMessage) (interface{}, error) {
+	var vars struct {
+		OwnerType string  `json:"ownerType"`
+		Namespace *string `json:"namespace"`
+		Key       *string `json:"key"`
+		After     *string `json:"after"`
+	}
+	if err := json.Unmarshal(variables, &vars); err != nil {
+		return nil, err
+	}
+	if vars.OwnerType == "" {
+		return nil, fmt.Errorf("Field 'metafieldDefinitions' is missing required arguments: ownerType")
+	}
+	definitions := []*shopify.MetafieldDefinition{}
+	for _, definition := range s.metafieldDefinitions {
+		if definition.OwnerType != vars.OwnerType ||
+			(vars.Namespace != nil && definition.Namespace != *vars.Namespace) ||
+			(vars.Key != nil && definition.Key != *vars.Key) {
+			continue
+		}
+		definitions = append(definitions, definition)
+	}
+	nodes, pageInfo := paginate(query, definitions, vars.After)
+	return map[string]interface{}{
+		"metafieldDefinitions": map[string]interface{}{
+			"nodes":    nodes,
+			"pageInfo": pageInfo,
+		},
+	}, nil
+}
+
+func (s *Server) updateMetafieldDefinition(query string, variables json.RawMessage) (interface{}, error) {
+	var vars struct {
+		Definition shopify.MetafieldDefinitionUpdateInput `json:"definition"`
+	}
+	if err := json.Unmarshal(variables, &vars); err != nil {
+		return nil, err
+	}
+	input := vars.Definition
+
+	var errs []shopify.UserError
+	updatedDefinition := s.findMetafieldDefinition(input.OwnerType, input.Namespace, input.Key)
+	if updatedDefinition == nil {
+		errs = append(errs, userError(shopify.UserErrorCodeNotFound, "Definition not found.", "definition"))
+	} else if input.Name == "" {
+		errs = append(errs, userError(shopify.UserErrorCodeBlank, "Name can't be blank", "definition", "name"))
+	}
+	errs = append(errs, validateMetafieldAccess(input.Namespace, input.Access)...)
+
+	if len(errs) == 0 {
+		updatedDefinition.Name = input.Name
+		updatedDefinition.Description = input.Description
+		updatedDefinition.Validations = validations(input.Validations)
+		updateMetafieldAccess(updatedDefinition.Access, input.Access)
+		updateMetafieldCapabilities(updatedDefinition.Capabilities, input.Capabilities)
+		s.setMetafieldDefinitionPin(updatedDefinition, input.Pin)
+	} else {
+		updatedDefinition = nil
+	}
+	return map[string]interface{}{
+		"metafieldDefinitionUpdate": map[string]interface{}{
+			"updatedDefinition": updatedDefinition,
+			"userErrors":        userErrors(errs),
+		},
+	}, nil
+}
+
+func (s *Server) deleteMetafieldDefinition(query string, variables json.RawMessage) (interface{}, error) {
+	var vars struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(variables, &vars); err != nil {
+		return nil, err
+	}
+
+	var errs []shopify.UserError
+	var deletedDefinitionID *string
+	for i, definition := range s.metafieldDefinitions {
+		if definition.ID == vars.ID {
+			s.metafieldDefinitions = append(s.metafieldDefinitions[:i], s.metafieldDefinitions[i+1:]...)
+			deletedDefinitionID = &vars.ID
+			break
+		}
+	}
+	if deletedDefinitionID == nil {
+		errs = append(errs, userError(shopify.UserErrorCodeNotFound, "Definition not found.", "id"))
+	}
+	return map[string]interface{}{
+		"metafieldDefinitionDelete": map[string]interface{}{
+			"deletedDefinitionId": deletedDefinitionID,
+			"userErrors":          userErrors(errs),
+		},
+	}, nil
+}
+
+// validations returns the validations as an empty list instead of null, as Shopify does.
+func validations(validations []*shopify.MetafieldDefinitionValidation) []*shopify.MetafieldDefinitionValidation {
+	if validations == nil {
+		return []*shopify.MetafieldDefinitionValidation{}
+	}
+	return validations
+}
+
+// validateMetafieldAccess rejects the admin access setting outside the namespaces reserved by apps.
+func validateMetafieldAccess(namespace string, input *shopify.MetafieldAccess) []shopify.UserError {
+	if input == nil || input.Admin == "" || strings.HasPrefix(namespace, "$app") || strings.HasPrefix(namespace, "app--") {
+		return nil
+	}
+	return []shopify.UserError{
+		userError("ADMIN_ACCESS_INPUT_NOT_ALLOWED", "Admin access can only be specified for app-owned metafield definitions.", "definition", "access", "admin"),
+	}
+}
+
+func updateMetafieldAccess(access *shopify.MetafieldAccess, input *shopify.MetafieldAccess) {
+	if input == nil {
+		return
+	}
+	if input.Admin != "" {
+		access.Admin = input.Admin
+	}
+	if input.Storefront != "" {
+		access.Storefront = input.Storefront
+	}
+	if input.CustomerAccount != "" {
+		access.CustomerAccount = input.CustomerAccount
+	}
+}
+
+func updateMetafieldCapabilities(capabilities *shopify.MetafieldCapabilities, input *shopify.MetafieldCapabilities) {
+	if input == nil {
+		return
+	}
+	if input.AdminFilterable != nil {
+		capabilities.AdminFilterable = &shopify.MetafieldCapability{Enabled: input.AdminFilterable.Enabled}
+	}
+	if input.SmartCollectionCondition != nil {
+		capabilities.SmartCollectionCondition = &shopify.MetafieldCapability{Enabled: input.SmartCollectionCondition.Enabled}
+	}
+}
@@ -0,0 +1,134 @@
+package shopifytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+)
+
+func TestServer_createMetafieldDefinition(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+	valid := shopify.MetafieldDefinitionInput{
+		Name:      "Care guide",
+		OwnerType: "PRODUCT",
+		Namespace: "custom",
+		Key:       "care_guide",
+		Type:      "multi_line_text_field",
+	}
+	if _, err := client.CreateMetafieldDefinition(ctx, &valid); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(input *shopify.MetafieldDefinitionInput)
+		wantCode string
+	}{
+		{
+			name:     "rejects a taken key",
+			modify:   func(input *shopify.MetafieldDefinitionInput) {},
+			wantCode: shopify.UserErrorCodeTaken,
+		},
+		{
+			name:     "rejects a blank name",
+			modify:   func(input *shopify.MetafieldDefinitionInput) { input.Key, input.Name = "other", "" },
+			wantCode: shopify.UserErrorCodeBlank,
+		},
+		{
+			name:     "rejects a reserved namespace",
+			modify:   func(input *shopify.MetafieldDefinitionInput) { input.Namespace = "shopify--discovery" },
+			wantCode: shopify.UserErrorCodeReservedNamespaceKey,
+		},
+		{
+			name:     "rejects an unknown type",
+			modify:   func(input *shopify.MetafieldDefinitionInput) { input.Key, input.Type = "other", "single_line_text" },
+			wantCode: "INCLUSION",
+		},
+		{
+			name: "rejects the admin access outside the namespaces of apps",
+			modify: func(input *shopify.MetafieldDefinitionInput) {
+				input.Key, input.Access = "other", &shopify.MetafieldAccess{Admin: "MERCHANT_READ"}
+			},
+			wantCode: "ADMIN_ACCESS_INPUT_NOT_ALLOWED",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := valid
+			tt.modify(&input)
+			_, err := client.CreateMetafieldDefinition(ctx, &input)
+			assertUserErrorCode(t, err, tt.wantCode)
+		})
+	}
+}
+
+func TestServer_metafieldDefinition(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+
+	created, err := client.CreateMetafieldDefinition(ctx, &shopify.MetafieldDefinitionInput{
+		Name:      "Rating",
+		OwnerType: "PRODUCT",
+		Namespace: "app--123--reviews",
+		Key:       "rating",
+		Type:      "rating",
+		Pin:       true,
+		Access:    &shopify.MetafieldAccess{Admin: "MERCHANT_READ", Storefront: "PUBLIC_READ"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.PinnedPosition == nil || *created.PinnedPosition != 1 {
+		t.Errorf("pinned position = %v, want 1", created.PinnedPosition)
+	}
+	if created.Access.Admin != "MERCHANT_READ" || created.Access.Storefront != "PUBLIC_READ" || created.Access.CustomerAccount != "NONE" {
+		t.Errorf("access = %+v, want the given access with the default customer account access", created.Access)
+	}
+
+	found, err := client.FindMetafieldDefinition(ctx, "PRODUCT", "app--123--reviews", "rating")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found == nil || found.ID != created.ID {
+		t.Errorf("FindMetafieldDefinition() = %v, want %s", found, created.ID)
+	}
+	definitions, err := client.ListMetafieldDefinitions(ctx, "COLLECTION")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(definitions) != 0 {
+		t.Errorf("got %d definitions of the other owner type, want 0", len(definitions))
+	}
+
+	// Settings omitted from the update are left unchanged.
+	updated, err := client.UpdateMetafieldDefinition(ctx, &shopify.MetafieldDefinitionUpdateInput{
+		Name:         "Product rating",
+		OwnerType:    "PRODUCT",
+		Namespace:    "app--123--reviews",
+		Key:          "rating",
+		Capabilities: &shopify.MetafieldCapabilities{AdminFilterable: &shopify.MetafieldCapability{Enabled: true}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "Product rating" || updated.PinnedPosition != nil {
+		t.Errorf("got name %q and pinned position %v, want the new name and no pin", updated.Name, updated.PinnedPosition)
+	}
+	if updated.Access.Storefront != "PUBLIC_READ" {
+		t.Errorf("storefront access = %q, want it unchanged", updated.Access.Storefront)
+	}
+	if !updated.Capabilities.AdminFilterable.Enabled || updated.Capabilities.SmartCollectionCondition.Enabled {
+		t.Errorf("capabilities = %+v, want only admin filterable enabled", updated.Capabilities)
+	}
+
+	if err := client.DeleteMetafieldDefinition(ctx, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetMetafieldDefinition(ctx, created.ID); !errors.Is(err, shopify.ErrNotFound) {
+		t.Errorf("GetMetafieldDefinition() error = %v, want ErrNotFound", err)
+	}
+	assertUserErrorCode(t, client.DeleteMetafieldDefinition(ctx, created.ID), shopify.UserErrorCodeNotFound)
+}
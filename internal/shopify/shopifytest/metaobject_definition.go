@@ -0,0 +1,382 @@
+package shopifytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+)
+
+var metaobjectTypeRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{3,255}$`)
+
+var metaobjectFieldKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{3,64}$`)
+
+func (s *Server) findMetaobjectDefinition(id string) *shopify.MetaobjectDefinition {
+	for _, definition := range s.metaobjectDefinitions {
+		if definition.ID == id {
+			return definition
+		}
+	}
+	return nil
+}
+
+func (s *Server) findMetaobjectDefinitionByType(definitionType string) *shopify.MetaobjectDefinition {
+	for _, definition := range s.metaobjectDefinitions {
+		if definition.Type == definitionType {
+			return definition
+		}
+	}
+	return nil
+}
+
+// validateMetaobjectFieldDefinition validates the field definition to create. The field is the path to the input.
+func validateMetaobjectFieldDefinition(input *shopify.MetaobjectFieldDefinitionCreateInput, field ...string) []shopify.UserError {
+	var errs []shopify.UserError
+	if !metaobjectFieldKeyRegex.MatchString(input.Key) {
+		errs = append(errs, userError(shopify.UserErrorCodeInvalid, "Key must be 3-64 characters long and only contain alphanumeric, hyphen, and underscore characters", append(field, "key")...))
+	}
+	if _, ok := metafieldDefinitionType(input.Type); !ok {
+		errs = append(errs, userError("INCLUSION", fmt.Sprintf("Type name %s is not a valid type", input.Type), append(field, "type")...))
+	}
+	return errs
+}
+
+func newMetaobjectFieldDefinition(input *shopify.MetaobjectFieldDefinitionCreateInput) *shopify.MetaobjectFieldDefinition {
+	definitionType, _ := metafieldDefinitionType(input.Type)
+	fieldDefinition := &shopify.MetaobjectFieldDefinition{
+		Key:         input.Key,
+		Type:        definitionType,
+		Required:    input.Required,
+		Validations: validations(input.Validations),
+	}
+	if input.Name != nil {
+		fieldDefinition.Name = *input.Name
+	}
+	if input.Description != nil {
+		fieldDefinition.Description = *input.Description
+	}
+	return fieldDefinition
+}
+
+func hasMetaobjectFieldDefinition(fieldDefinitions []*shopify.MetaobjectFieldDefinition, key string) bool {
+	for _, fieldDefinition := range fieldDefinitions {
+		if fieldDefinition.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+func validateDisplayNameKey(displayNameKey *string, fieldDefinitions []*shopify.MetaobjectFieldDefinition) []shopify.UserError {
+	if displayNameKey == nil || hasMetaobjectFieldDefinition(fieldDefinitions, *displayNameKey) {
+		return nil
+	}
+	return []shopify.UserError{userError(shopify.UserErrorCodeInvalid, fmt.Sprintf("Display name key %s is not the key of a field definition", *displayNameKey), "definition", "displayNameKey")}
+}
+
+func (s *Server) createMetaobjectDefinition(query string, variables json.RawMessage) (interface{}, error) {
+	var vars struct {
+		Definition shopify.MetaobjectDefinitionCreateInput `json:"definition"`
+	}
+	if err := json.Unmarshal(variables, &vars); err != nil {
+		return nil, err
+	}
+	input := vars.Definition
+
+	var errs []shopify.UserError
+	if input.Name == "" {
+		errs = append(errs, userError(shopify.UserErrorCodeBlank, "Name can't be blank", "definition", "name"))
+	}
+	if !metaobjectTypeRegex.MatchString(input.Type) {
+		errs = append(errs, userError(shopify.UserErrorCodeInvalid, "Type must be 3-255 characters long and only contain alphanumeric, hyphen, and underscore characters", "definition", "type"))
+	} else if s.findMetaobjectDefinitionByType(input.Type) != nil {
+		errs = append(errs, userError(shopify.UserErrorCodeTaken, "Type has already been taken", "definition", "type"))
+	}
+	fieldDefinitions := make([]*shopify.MetaobjectFieldDefinition, 0, len(input.FieldDefinitions))
+	keys := map[string]bool{}
+	for i, fieldDefinitionInput := range input.FieldDefinitions {
+		field := []string{"definition", "fieldDefinitions", strconv.Itoa(i)}
+		if keys[fieldDefinitionInput.Key] {
+			errs = append(errs, userError(shopify.UserErrorCodeTaken, "Key has already been taken", append(field, "key")...))
+			continue
+		}
+		keys[fieldDefinitionInput.Key] = true
+		errs = append(errs, validateMetaobjectFieldDefinition(fieldDefinitionInput, field...)...)
+		fieldDefinitions = append(fieldDefinitions, newMetaobjectFieldDefinition(fieldDefinitionInput))
+	}
+	errs = append(errs, validateDisplayNameKey(input.DisplayNameKey, fieldDefinitions)...)
+	errs = append(errs, validateMetaobjectDefinitionCapabilities(input.Capabilities, fieldDefinitions)...)
+
+	var createdDefinition *shopify.MetaobjectDefinition
+	if len(errs) == 0 {
+		createdDefinition = &shopify.MetaobjectDefinition{
+			ID:               fmt.Sprintf("gid://shopify/MetaobjectDefinition/%d", s.nextID()),
+			Type:             input.Type,
+			Name:             input.Name,
+			DisplayNameKey:   input.DisplayNameKey,
+			FieldDefinitions: fieldDefinitions,
+			Access: &shopify.MetaobjectAccess{
+				Admin:      "PUBLIC_READ_WRITE",
+				Storefront: "NONE",
+			},
+			Capabilities: &shopify.MetaobjectDefinitionCapabilities{},
+		}
+		if input.Description != nil {
+			createdDefinition.Description = *input.Description
+		}
+		updateMetaobjectAccess(createdDefinition.Access, input.Access)
+		updateMetaobjectDefinitionCapabilities(createdDefinition.Capabilities, input.Capabilities)
+		s.metaobjectDefinitions = append(s.metaobjectDefinitions, createdDefinition)
+	}
+	return map[string]interface{}{
+		"metaobjectDefinitionCreate": map[string]interface{}{
+			"metaobjectDefinition": createdDefinition,
+			"userErrors":           userErrors(errs),
+		},
+	}, nil
+}
+
+func (s *Server) getMetaobjectDefinition(query string, variables json.RawMessage) (interface{}, error) {
+	var vars struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(variables, &vars); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"metaobjectDefinition": s.findMetaobjectDefinition(vars.ID)}, nil
+}
+
+func (s *Server) getMetaobjectDefinitionByType(query string, variables json.RawMessage) (interface{}, error) {
+	var vars struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(variables, &vars); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"metaobjectDefinitionByType": s.findMetaobjectDefinitionByType(vars.Type)}, nil
+}
+
+func (s *Server) listMetaobjectDefinitions(query string, variables json.RawMessage) (interface{}, error) {
+	var vars struct {
+		After *string `json:"after"`
+	}
+	if err := json.Unmarshal(variables, &vars); err != nil {
+		return nil, err
+	}
+	nodes, pageInfo := paginate(query, s.metaobjectDefinitions, vars.After)
+	if nodes == nil {
+		nodes = []*shopify.MetaobjectDefinition{}
+	}
+	return map[string]interface{}{
+		"metaobjectDefinitions": map[string]interface{}{
+			"nodes":    nodes,
+			"pageInfo": pageInfo,
+		},
+	}, nil
+}
+
+// updateMetaobjectDefinition applies all the field definition operations or none of them, as Shopify does.
+func (s *Server) updateMetaobjectDefinition(query string, variables json.RawMessage) (interface{}, error) {
+	var vars struct {
+		ID         string                                  `json:"id"`
+		Definition shopify.MetaobjectDefinitionUpdateInput `json:"definition"`
+	}
+	if err := json.Unmarshal(variables, &vars); err != nil {
+		return nil, err
+	}
+	input := vars.Definition
+
+	definition := s.findMetaobjectDefinition(vars.ID)
+	if definition == nil {
+		return map[string]interface{}{
+			"metaobjectDefinitionUpdate": map[string]interface{}{
+				"metaobjectDefinition": nil,
+				"userErrors":           []shopify.UserError{userError(shopify.UserErrorCodeNotFound, "Record not found", "id")},
+			},
+		}, nil
+	}
+
+	var errs []shopify.UserError
+	if input.Name == "" {
+		errs = append(errs, userError(shopify.UserErrorCodeBlank, "Name can't be blank", "definition", "name"))
+	}
+	fieldDefinitions := make([]*shopify.MetaobjectFieldDefinition, 0, len(definition.FieldDefinitions))
+	for _, fieldDefinition := range definition.FieldDefinitions {
+		copied := *fieldDefinition
+		fieldDefinitions = append(fieldDefinitions, &copied)
+	}
+	indexOf := func(key string) int {
+		for i, fieldDefinition := range fieldDefinitions {
+			if fieldDefinition.Key == key {
+				return i
+			}
+		}
+		return -1
+	}
+	for i, operation := range input.FieldDefinitions {
+		field := []string{"definition", "fieldDefinitions", strconv.Itoa(i)}
+		switch {
+		case operation.Create != nil:
+			field = append(field, "create")
+			if indexOf(operation.Create.Key) >= 0 {
+				errs = append(errs, userError(shopify.UserErrorCodeTaken, "Key has already been taken", append(field, "key")...))
+				continue
+			}
+			if fieldErrs := validateMetaobjectFieldDefinition(operation.Create, field...); len(fieldErrs) > 0 {
+				errs = append(errs, fieldErrs...)
+				continue
+			}
+			fieldDefinitions = append(fieldDefinitions, newMetaobjectFieldDefinition(operation.Create))
+		case operation.Update != nil:
+			field = append(field, "update")
+			index := indexOf(operation.Update.Key)
+			if index < 0 {
+				errs = append(errs, userError(shopify.UserErrorCodeNotFound, "Field definition not found", append(field, "key")...))
+				continue
+			}
+			fieldDefinition := fieldDefinitions[index]
+			if operation.Update.Type != nil && *operation.Update.Type != fieldDefinition.Type.Name {
+				definitionType, ok := metafieldDefinitionType(*operation.Update.Type)
+				if !ok || !shopify.CanMigrateMetafieldType(fieldDefinition.Type.Name, *operation.Update.Type) {
+					errs = append(errs, userError(shopify.UserErrorCodeInvalid, fmt.Sprintf("Type can't be changed from %s to %s", fieldDefinition.Type.Name, *operation.Update.Type), append(field, "type")...))
+					continue
+				}
+				fieldDefinition.Type = definitionType
+			}
+			if operation.Update.Name != nil {
+				fieldDefinition.Name = *operation.Update.Name
+			}
+			if operation.Update.Description != nil {
+				fieldDefinition.Description = *operation.Update.Description
+			}
+			fieldDefinition.Required = operation.Update.Required
+			fieldDefinition.Validations = validations(operation.Update.Validations)
+		case operation.Delete != nil:
+			field = append(field, "delete")
+			index := indexOf(operation.Delete.Key)
+			if index < 0 {
+				errs = append(errs, userError(shopify.UserErrorCodeNotFound, "Field definition not found", append(field, "key")...))
+				continue
+			}
+			fieldDefinitions = append(fieldDefinitions[:index], fieldDefinitions[index+1:]...)
+		default:
+			errs = append(errs, userError(shopify.UserErrorCodeInvalid, "Exactly one of create, update or delete must be given", field...))
+		}
+	}
+	displayNameKey := definition.DisplayNameKey
+	if input.DisplayNameKey != nil {
+		displayNameKey = input.DisplayNameKey
+	}
+	errs = append(errs, validateDisplayNameKey(displayNameKey, fieldDefinitions)...)
+	errs = append(errs, validateMetaobjectDefinitionCapabilities(input.Capabilities, fieldDefinitions)...)
+
+	var updatedDefinition *shopify.MetaobjectDefinition
+	if len(errs) == 0 {
+		definition.Name = input.Name
+		if input.Description != nil {
+			definition.Description = *input.Description
+		}
+		definition.DisplayNameKey = displayNameKey
+		definition.FieldDefinitions = fieldDefinitions
+		updateMetaobjectAccess(definition.Access, input.Access)
+		updateMetaobjectDefinitionCapabilities(definition.Capabilities, input.Capabilities)
+		updatedDefinition = definition
+	}
+	return map[string]interface{}{
+		"metaobjectDefinitionUpdate": map[string]interface{}{
+			"metaobjectDefinition": updatedDefinition,
+			"userErrors":           userErrors(errs),
+		},
+	}, nil
+}
+
+func (s *Server) deleteMetaobjectDefinition(query string, variables json.RawMessage) (interface{}, error) {
+	var vars struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(variables, &vars); err != nil {
+		return nil, err
+	}
+
+	var errs []shopify.UserError
+	var deletedID *string
+	for i, definition := range s.metaobjectDefinitions {
+		if definition.ID == vars.ID {
+			s.metaobjectDefinitions = append(s.metaobjectDefinitions[:i], s.metaobjectDefinitions[i+1:]...)
+			deletedID = &vars.ID
+			break
+		}
+	}
+	if deletedID == nil {
+		errs = append(errs, userError(shopify.UserErrorCodeNotFound, "Record not found", "id"))
+	}
+	return map[string]interface{}{
+		"metaobjectDefinitionDelete": map[string]interface{}{
+			"deletedId":  deletedID,
+			"userErrors": userErrors(errs),
+		},
+	}, nil
+}
+
+func updateMetaobjectAccess(access *shopify.MetaobjectAccess, input *shopify.MetaobjectAccess) {
+	if input == nil {
+		return
+	}
+	if input.Admin != "" {
+		access.Admin = input.Admin
+	}
+	if input.Storefront != "" {
+		access.Storefront = input.Storefront
+	}
+}
+
+// validateMetaobjectDefinitionCapabilities validates the data of the capabilities to enable.
+func validateMetaobjectDefinitionCapabilities(input *shopify.MetaobjectDefinitionCapabilitiesInput, fieldDefinitions []*shopify.MetaobjectFieldDefinition) []shopify.UserError {
+	if input == nil {
+		return nil
+	}
+	var errs []shopify.UserError
+	if renderable := input.Renderable; renderable != nil && renderable.Enabled && renderable.Data != nil {
+		field := []string{"definition", "capabilities", "renderable", "data"}
+		for name, key := range map[string]*string{"metaTitleKey": renderable.Data.MetaTitleKey, "metaDescriptionKey": renderable.Data.MetaDescriptionKey} {
+			if key != nil && !hasMetaobjectFieldDefinition(fieldDefinitions, *key) {
+				errs = append(errs, userError(shopify.UserErrorCodeInvalid, fmt.Sprintf("%s is not the key of a field definition", *key), append(field, name)...))
+			}
+		}
+	}
+	if onlineStore := input.OnlineStore; onlineStore != nil && onlineStore.Enabled {
+		if onlineStore.Data == nil || onlineStore.Data.URLHandle == "" {
+			errs = append(errs, userError(shopify.UserErrorCodeBlank, "Url handle can't be blank", "definition", "capabilities", "onlineStore", "data", "urlHandle"))
+		}
+	}
+	return errs
+}
+
+func updateMetaobjectDefinitionCapabilities(capabilities *shopify.MetaobjectDefinitionCapabilities, input *shopify.MetaobjectDefinitionCapabilitiesInput) {
+	if input == nil {
+		return
+	}
+	if input.Publishable != nil {
+		capabilities.Publishable.Enabled = input.Publishable.Enabled
+	}
+	if input.Translatable != nil {
+		capabilities.Translatable.Enabled = input.Translatable.Enabled
+	}
+	if input.Renderable != nil {
+		capabilities.Renderable = shopify.MetaobjectDefinitionCapabilityRenderable{Enabled: input.Renderable.Enabled}
+		if input.Renderable.Enabled {
+			capabilities.Renderable.Data = input.Renderable.Data
+		}
+	}
+	if input.OnlineStore != nil {
+		capabilities.OnlineStore = shopify.MetaobjectDefinitionCapabilityOnlineStore{Enabled: input.OnlineStore.Enabled}
+		if input.OnlineStore.Enabled {
+			capabilities.OnlineStore.Data = &shopify.MetaobjectDefinitionCapabilityOnlineStoreData{
+				URLHandle:          input.OnlineStore.Data.URLHandle,
+				CanCreateRedirects: input.OnlineStore.Data.CreateRedirects,
+			}
+		}
+	}
+}
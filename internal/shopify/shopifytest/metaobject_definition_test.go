@@ -0,0 +1,142 @@
+package shopifytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+)
+
+func TestServer_createMetaobjectDefinition(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+	valid := shopify.MetaobjectDefinitionCreateInput{
+		Type: "designer",
+		Name: "Designer",
+		FieldDefinitions: []*shopify.MetaobjectFieldDefinitionCreateInput{
+			{Key: "name", Type: "single_line_text_field"},
+		},
+	}
+	if _, err := client.CreateMetaobjectDefinition(ctx, &valid); err != nil {
+		t.Fatal(err)
+	}
+
+	displayNameKey := "unknown"
+	tests := []struct {
+		name     string
+		modify   func(input *shopify.MetaobjectDefinitionCreateInput)
+		wantCode string
+	}{
+		{
+			name:     "rejects a taken type",
+			modify:   func(input *shopify.MetaobjectDefinitionCreateInput) {},
+			wantCode: shopify.UserErrorCodeTaken,
+		},
+		{
+			name: "rejects duplicate field keys",
+			modify: func(input *shopify.MetaobjectDefinitionCreateInput) {
+				input.Type = "other"
+				input.FieldDefinitions = append(input.FieldDefinitions, input.FieldDefinitions[0])
+			},
+			wantCode: shopify.UserErrorCodeTaken,
+		},
+		{
+			name: "rejects a display name key of no field",
+			modify: func(input *shopify.MetaobjectDefinitionCreateInput) {
+				input.Type, input.DisplayNameKey = "other", &displayNameKey
+			},
+			wantCode: shopify.UserErrorCodeInvalid,
+		},
+		{
+			name: "rejects the online store capability without URL handle",
+			modify: func(input *shopify.MetaobjectDefinitionCreateInput) {
+				input.Type = "other"
+				input.Capabilities = &shopify.MetaobjectDefinitionCapabilitiesInput{
+					OnlineStore: &shopify.MetaobjectDefinitionCapabilityOnlineStoreInput{Enabled: true},
+				}
+			},
+			wantCode: shopify.UserErrorCodeBlank,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := valid
+			input.FieldDefinitions = append([]*shopify.MetaobjectFieldDefinitionCreateInput(nil), valid.FieldDefinitions...)
+			tt.modify(&input)
+			_, err := client.CreateMetaobjectDefinition(ctx, &input)
+			assertUserErrorCode(t, err, tt.wantCode)
+		})
+	}
+}
+
+func TestServer_updateMetaobjectDefinition(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+	created, err := client.CreateMetaobjectDefinition(ctx, &shopify.MetaobjectDefinitionCreateInput{
+		Type: "designer",
+		Name: "Designer",
+		FieldDefinitions: []*shopify.MetaobjectFieldDefinitionCreateInput{
+			{Key: "name", Type: "single_line_text_field"},
+			{Key: "bio", Type: "single_line_text_field"},
+			{Key: "age", Type: "number_integer"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	migratedType, incompatibleType := "multi_line_text_field", "number_integer"
+
+	// The operations are applied all or nothing.
+	_, err = client.UpdateMetaobjectDefinition(ctx, created.ID, &shopify.MetaobjectDefinitionUpdateInput{
+		Name: "Designer",
+		FieldDefinitions: []*shopify.MetaobjectFieldDefinitionOperationInput{
+			{Delete: &shopify.MetaobjectFieldDefinitionDeleteInput{Key: "age"}},
+			{Update: &shopify.MetaobjectFieldDefinitionUpdateInput{Key: "name", Type: &incompatibleType}},
+		},
+	})
+	assertUserErrorCode(t, err, shopify.UserErrorCodeInvalid)
+	definition, err := client.GetMetaobjectDefinition(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(definition.FieldDefinitions) != 3 {
+		t.Errorf("got %d field definitions after the failed update, want 3", len(definition.FieldDefinitions))
+	}
+
+	updated, err := client.UpdateMetaobjectDefinition(ctx, created.ID, &shopify.MetaobjectDefinitionUpdateInput{
+		Name: "Fashion designer",
+		FieldDefinitions: []*shopify.MetaobjectFieldDefinitionOperationInput{
+			{Create: &shopify.MetaobjectFieldDefinitionCreateInput{Key: "website", Type: "url"}},
+			{Update: &shopify.MetaobjectFieldDefinitionUpdateInput{Key: "bio", Type: &migratedType, Required: true}},
+			{Delete: &shopify.MetaobjectFieldDefinitionDeleteInput{Key: "age"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"name": "single_line_text_field", "bio": "multi_line_text_field", "website": "url"}
+	if updated.Name != "Fashion designer" || len(updated.FieldDefinitions) != len(want) {
+		t.Fatalf("got %q with %d field definitions, want the new name with %d", updated.Name, len(updated.FieldDefinitions), len(want))
+	}
+	for _, fieldDefinition := range updated.FieldDefinitions {
+		if fieldDefinition.Type.Name != want[fieldDefinition.Key] {
+			t.Errorf("type of %s = %s, want %s", fieldDefinition.Key, fieldDefinition.Type.Name, want[fieldDefinition.Key])
+		}
+	}
+
+	_, err = client.UpdateMetaobjectDefinition(ctx, created.ID, &shopify.MetaobjectDefinitionUpdateInput{
+		Name: "Fashion designer",
+		FieldDefinitions: []*shopify.MetaobjectFieldDefinitionOperationInput{
+			{Delete: &shopify.MetaobjectFieldDefinitionDeleteInput{Key: "age"}},
+		},
+	})
+	assertUserErrorCode(t, err, shopify.UserErrorCodeNotFound)
+
+	if err := client.DeleteMetaobjectDefinition(ctx, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetMetaobjectDefinition(ctx, created.ID); !errors.Is(err, shopify.ErrNotFound) {
+		t.Errorf("GetMetaobjectDefinition() error = %v, want ErrNotFound", err)
+	}
+}
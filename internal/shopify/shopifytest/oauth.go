@@ -0,0 +1,41 @@
+package shopifytest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// accessTokenLifetime is the lifetime in seconds of the tokens issued with the client credentials grant, as Shopify does.
+const accessTokenLifetime = 86399
+
+// handleAccessToken issues an access token with the client credentials grant to any client with a secret.
+func (s *Server) handleAccessToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSON(w, http.StatusMethodNotAllowed, map[string]interface{}{"errors": "Method Not Allowed"})
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{"error": "invalid_request", "error_description": err.Error()})
+		return
+	}
+	if grantType := r.PostForm.Get("grant_type"); grantType != "client_credentials" {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{
+			"error":             "unsupported_grant_type",
+			"error_description": fmt.Sprintf("grant type %q is not supported by the fake server", grantType),
+		})
+		return
+	}
+	if r.PostForm.Get("client_id") == "" || r.PostForm.Get("client_secret") == "" {
+		writeJSON(w, http.StatusUnauthorized, map[string]interface{}{
+			"error":             "invalid_client",
+			"error_description": "Client authentication failed due to unknown client, no client authentication included, or unsupported authentication method.",
+		})
+		return
+	}
+	accessToken := fmt.Sprintf("shpat_fake%d", s.nextID())
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": accessToken,
+		"scope":        "read_content,write_content",
+		"expires_in":   accessTokenLifetime,
+	})
+}
@@ -0,0 +1,64 @@
+package shopifytest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestServer_handleAccessToken(t *testing.T) {
+	server := NewServer()
+	t.Cleanup(server.Close)
+	tests := []struct {
+		name       string
+		form       url.Values
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:       "issues an access token",
+			form:       url.Values{"grant_type": {"client_credentials"}, "client_id": {"id"}, "client_secret": {"secret"}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "rejects other grant types",
+			form:       url.Values{"grant_type": {"authorization_code"}, "client_id": {"id"}, "client_secret": {"secret"}},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "unsupported_grant_type",
+		},
+		{
+			name:       "rejects clients without secret",
+			form:       url.Values{"grant_type": {"client_credentials"}, "client_id": {"id"}},
+			wantStatus: http.StatusUnauthorized,
+			wantError:  "invalid_client",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Post(server.URL+"/admin/oauth/access_token", "application/x-www-form-urlencoded", strings.NewReader(tt.form.Encode()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			var body struct {
+				AccessToken string `json:"access_token"`
+				ExpiresIn   int    `json:"expires_in"`
+				Error       string `json:"error"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if body.Error != tt.wantError {
+				t.Errorf("error = %q, want %q", body.Error, tt.wantError)
+			}
+			if tt.wantError == "" && (!strings.HasPrefix(body.AccessToken, "shpat_") || body.ExpiresIn != accessTokenLifetime) {
+				t.Errorf("got access token %q expiring in %d, want a shpat_ token expiring in %d", body.AccessToken, body.ExpiresIn, accessTokenLifetime)
+			}
+		})
+	}
+}
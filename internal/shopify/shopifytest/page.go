@@ -0,0 +1,149 @@
+package shopifytest
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+type pageResource struct {
+	Page *goshopify.Page `json:"page"`
+}
+
+func (s *Server) handlePages(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		sinceID, _ := strconv.ParseUint(query.Get("since_id"), 10, 64)
+		limit, err := strconv.Atoi(query.Get("limit"))
+		if err != nil || limit <= 0 {
+			limit = 50
+		}
+		pages := []*goshopify.Page{}
+		for _, page := range s.pages {
+			if page.Id <= sinceID || (query.Has("handle") && page.Handle != query.Get("handle")) {
+				continue
+			}
+			if len(pages) == limit {
+				break
+			}
+			pages = append(pages, page)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"pages": pages})
+	case http.MethodPost:
+		var body pageResource
+		if err := decodeJSON(r.Body, &body); err != nil || body.Page == nil {
+			writeJSON(w, http.StatusBadRequest, map[string]interface{}{"errors": map[string]interface{}{"page": "Required parameter missing or invalid"}})
+			return
+		}
+		input := body.Page
+		if input.Title == "" {
+			writeJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{"errors": map[string]interface{}{"title": []string{"can't be blank"}}})
+			return
+		}
+		now := time.Now().UTC().Truncate(time.Second)
+		page := &goshopify.Page{
+			Id:             s.nextID(),
+			Author:         input.Author,
+			Handle:         s.uniquePageHandle(input.Handle, input.Title, 0),
+			Title:          input.Title,
+			BodyHTML:       input.BodyHTML,
+			TemplateSuffix: input.TemplateSuffix,
+			CreatedAt:      &now,
+			UpdatedAt:      &now,
+		}
+		// Pages are published unless published is false.
+		if input.Published == nil || *input.Published {
+			page.PublishedAt = &now
+		}
+		s.pages = append(s.pages, page)
+		writeJSON(w, http.StatusCreated, pageResource{Page: page})
+	default:
+		writeJSON(w, http.StatusMethodNotAllowed, map[string]interface{}{"errors": "Method Not Allowed"})
+	}
+}
+
+func (s *Server) handlePage(w http.ResponseWriter, r *http.Request, id uint64) {
+	index := -1
+	for i, page := range s.pages {
+		if page.Id == id {
+			index = i
+		}
+	}
+	if index < 0 {
+		writeJSON(w, http.StatusNotFound, map[string]interface{}{"errors": "Not Found"})
+		return
+	}
+	page := s.pages[index]
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, pageResource{Page: page})
+	case http.MethodPut:
+		var body pageResource
+		if err := decodeJSON(r.Body, &body); err != nil || body.Page == nil {
+			writeJSON(w, http.StatusBadRequest, map[string]interface{}{"errors": map[string]interface{}{"page": "Required parameter missing or invalid"}})
+			return
+		}
+		// Fields omitted from the request are left unchanged.
+		input := body.Page
+		if input.Author != "" {
+			page.Author = input.Author
+		}
+		if input.Title != "" {
+			page.Title = input.Title
+		}
+		if input.Handle != "" && input.Handle != page.Handle {
+			page.Handle = s.uniquePageHandle(input.Handle, page.Title, page.Id)
+		}
+		if input.BodyHTML != "" {
+			page.BodyHTML = input.BodyHTML
+		}
+		if input.TemplateSuffix != "" {
+			page.TemplateSuffix = input.TemplateSuffix
+		}
+		now := time.Now().UTC().Truncate(time.Second)
+		if input.Published != nil {
+			if !*input.Published {
+				page.PublishedAt = nil
+			} else if page.PublishedAt == nil {
+				page.PublishedAt = &now
+			}
+		}
+		page.UpdatedAt = &now
+		writeJSON(w, http.StatusOK, pageResource{Page: page})
+	case http.MethodDelete:
+		s.pages = append(s.pages[:index], s.pages[index+1:]...)
+		writeJSON(w, http.StatusOK, map[string]interface{}{})
+	default:
+		writeJSON(w, http.StatusMethodNotAllowed, map[string]interface{}{"errors": "Method Not Allowed"})
+	}
+}
+
+var invalidHandleCharRegex = regexp.MustCompile(`[^a-z0-9]+`)
+
+// uniquePageHandle returns the handle, generated from the title if it's empty,
+// with a numeric suffix if it's taken by another page, as Shopify does.
+func (s *Server) uniquePageHandle(handle string, title string, id uint64) string {
+	if handle == "" {
+		handle = strings.Trim(invalidHandleCharRegex.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	}
+	isTaken := func(handle string) bool {
+		for _, page := range s.pages {
+			if page.Handle == handle && page.Id != id {
+				return true
+			}
+		}
+		return false
+	}
+	uniqueHandle := handle
+	for i := 1; isTaken(uniqueHandle); i++ {
+		uniqueHandle = fmt.Sprintf("%s-%d", handle, i)
+	}
+	return uniqueHandle
+}
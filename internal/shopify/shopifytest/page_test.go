@@ -0,0 +1,65 @@
+package shopifytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+)
+
+func TestServer_handlePages(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+	published := false
+
+	created, err := client.Page().Create(ctx, goshopify.Page{Title: "About Us!", BodyHTML: "<p>About</p>", Published: &published})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Handle != "about-us" || created.PublishedAt != nil {
+		t.Errorf("got handle %q published at %v, want the handle generated from the title and unpublished", created.Handle, created.PublishedAt)
+	}
+	duplicate, err := client.Page().Create(ctx, goshopify.Page{Title: "About", Handle: "about-us"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if duplicate.Handle != "about-us-1" || duplicate.PublishedAt == nil {
+		t.Errorf("got handle %q published at %v, want the suffixed handle and published", duplicate.Handle, duplicate.PublishedAt)
+	}
+	if _, err := client.Page().Create(ctx, goshopify.Page{Handle: "untitled"}); err == nil {
+		t.Error("Create() succeeded without title")
+	}
+
+	found, err := client.FindPageByHandle(ctx, "about-us-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found == nil || found.Id != duplicate.Id {
+		t.Errorf("FindPageByHandle() = %v, want %d", found, duplicate.Id)
+	}
+
+	// Fields omitted from the update are left unchanged.
+	updated, err := client.Page().Update(ctx, goshopify.Page{Id: created.Id, Title: "About us"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Title != "About us" || updated.BodyHTML != "<p>About</p>" || updated.Handle != "about-us" {
+		t.Errorf("got %+v, want only the title updated", updated)
+	}
+
+	if err := client.Page().Delete(ctx, created.Id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetPage(ctx, created.Id); !errors.Is(err, shopify.ErrNotFound) {
+		t.Errorf("GetPage() error = %v, want ErrNotFound", err)
+	}
+	pages, err := client.ListPages(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pages) != 1 || pages[0].Id != duplicate.Id {
+		t.Errorf("ListPages() = %v, want only %d", pages, duplicate.Id)
+	}
+}
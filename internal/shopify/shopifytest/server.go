@@ -0,0 +1,231 @@
+// Package shopifytest provides an in-memory fake of the Shopify Admin API for tests.
+//
+// Only the client credentials grant, the metafield definitions and the metaobject definitions of the GraphQL Admin API
+// and the pages of the REST Admin API are implemented. Other operations fail with a GraphQL error or 404.
+package shopifytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+)
+
+// Server is a fake Shopify Admin API server keeping the resources in memory.
+// Requests are accepted for any shop and API version as long as an access token is given.
+type Server struct {
+	*httptest.Server
+
+	mu                    sync.Mutex
+	lastID                uint64
+	metafieldDefinitions  []*shopify.MetafieldDefinition
+	metaobjectDefinitions []*shopify.MetaobjectDefinition
+	pages                 []*goshopify.Page
+	failureHook           FailureHook
+}
+
+// FailureHook is called before each GraphQL operation with the operation name and the variables.
+// When it returns an error, the operation fails with the error as a GraphQL error without being applied,
+// e.g. to test how the provider handles a failure in the middle of the requests of an apply.
+type FailureHook func(operationName string, variables json.RawMessage) error
+
+// SetFailureHook sets the hook called before each GraphQL operation. A nil hook clears it.
+func (s *Server) SetFailureHook(hook FailureHook) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failureHook = hook
+}
+
+// NewServer starts and returns a new fake server. The caller should call Close when finished.
+func NewServer() *Server {
+	s := &Server{}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+var restPathRegex = regexp.MustCompile(`^/admin/api/[^/]+/(.+)\.json$`)
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/admin/oauth/access_token" {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.handleAccessToken(w, r)
+		return
+	}
+	if r.Header.Get("X-Shopify-Access-Token") == "" {
+		writeJSON(w, http.StatusUnauthorized, map[string]interface{}{
+			"errors": "[API] Invalid API key or access token (unrecognized login or wrong password)",
+		})
+		return
+	}
+
+	matches := restPathRegex.FindStringSubmatch(r.URL.Path)
+	if matches == nil {
+		writeJSON(w, http.StatusNotFound, map[string]interface{}{"errors": "Not Found"})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resource := matches[1]
+	switch {
+	case resource == "graphql" && r.Method == http.MethodPost:
+		s.handleGraphQL(w, r)
+	case resource == "pages":
+		s.handlePages(w, r)
+	case strings.HasPrefix(resource, "pages/"):
+		id, err := strconv.ParseUint(strings.TrimPrefix(resource, "pages/"), 10, 64)
+		if err != nil {
+			writeJSON(w, http.StatusNotFound, map[string]interface{}{"errors": "Not Found"})
+			return
+		}
+		s.handlePage(w, r, id)
+	default:
+		writeJSON(w, http.StatusNotFound, map[string]interface{}{"errors": "Not Found"})
+	}
+}
+
+type graphQLRequest struct {
+	Query     string          `json:"query"`
+	Variables json.RawMessage `json:"variables"`
+}
+
+// graphQLHandler handles an operation and returns the data of the response.
+type graphQLHandler func(s *Server, query string, variables json.RawMessage) (interface{}, error)
+
+// graphQLHandlers are keyed by the operation names used in the shopify package.
+var graphQLHandlers = map[string]graphQLHandler{
+	"CreateMetafieldDefinition":  (*Server).createMetafieldDefinition,
+	"metafieldDefinition":        (*Server).getMetafieldDefinition,
+	"metafieldDefinitions":       (*Server).listMetafieldDefinitions,
+	"UpdateMetafieldDefinition":  (*Server).updateMetafieldDefinition,
+	"DeleteMetafieldDefinition":  (*Server).deleteMetafieldDefinition,
+	"CreateMetaobjectDefinition": (*Server).createMetaobjectDefinition,
+	"metaobjectDefinition":       (*Server).getMetaobjectDefinition,
+	"metaobjectDefinitionByType": (*Server).getMetaobjectDefinitionByType,
+	"metaobjectDefinitions":      (*Server).listMetaobjectDefinitions,
+	"UpdateMetaobjectDefinition": (*Server).updateMetaobjectDefinition,
+	"DeleteMetaobjectDefinition": (*Server).deleteMetaobjectDefinition,
+}
+
+var operationNameRegex = regexp.MustCompile(`^\s*(?:query|mutation)\s+(\w+)`)
+
+func (s *Server) handleGraphQL(w http.ResponseWriter, r *http.Request) {
+	var req graphQLRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeGraphQLError(w, fmt.Sprintf("invalid request body: %s", err))
+		return
+	}
+	matches := operationNameRegex.FindStringSubmatch(req.Query)
+	if matches == nil {
+		writeGraphQLError(w, "operation name is required by the fake server")
+		return
+	}
+	handler, ok := graphQLHandlers[matches[1]]
+	if !ok {
+		writeGraphQLError(w, fmt.Sprintf("operation %s is not supported by the fake server", matches[1]))
+		return
+	}
+	if s.failureHook != nil {
+		if err := s.failureHook(matches[1], req.Variables); err != nil {
+			writeGraphQLError(w, err.Error())
+			return
+		}
+	}
+	data, err := handler(s, req.Query, req.Variables)
+	if err != nil {
+		writeGraphQLError(w, err.Error())
+		return
+	}
+	actualQueryCost := 10.0
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": data,
+		"extensions": map[string]interface{}{
+			"cost": shopify.QueryCost{
+				RequestedQueryCost: 10,
+				ActualQueryCost:    &actualQueryCost,
+				ThrottleStatus: shopify.ThrottleStatus{
+					MaximumAvailable:   2000,
+					CurrentlyAvailable: 1990,
+					RestoreRate:        100,
+				},
+			},
+		},
+	})
+}
+
+func (s *Server) nextID() uint64 {
+	s.lastID++
+	return s.lastID
+}
+
+func writeGraphQLError(w http.ResponseWriter, message string) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"errors": []map[string]interface{}{{"message": message}},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func decodeJSON(r io.Reader, v interface{}) error {
+	return json.NewDecoder(r).Decode(v)
+}
+
+func userError(code string, message string, field ...string) shopify.UserError {
+	// The field is copied since callers build it by appending to a shared prefix.
+	return shopify.UserError{Code: &code, Field: append([]string(nil), field...), Message: message}
+}
+
+// userErrors returns the user errors as an empty list instead of null when there is no error, as Shopify does.
+func userErrors(errs []shopify.UserError) []shopify.UserError {
+	if errs == nil {
+		return []shopify.UserError{}
+	}
+	return errs
+}
+
+var firstRegex = regexp.MustCompile(`first:\s*(\d+)`)
+
+// paginate returns the page of the nodes specified by `first` in the query and the `after` cursor.
+// The cursors are the indexes of the nodes.
+func paginate[T any](query string, nodes []T, after *string) ([]T, map[string]interface{}) {
+	first := len(nodes)
+	if matches := firstRegex.FindStringSubmatch(query); matches != nil {
+		first, _ = strconv.Atoi(matches[1])
+	}
+	start := 0
+	if after != nil {
+		if index, err := strconv.Atoi(*after); err == nil {
+			start = index + 1
+		}
+	}
+	if start > len(nodes) {
+		start = len(nodes)
+	}
+	end := start + first
+	if end > len(nodes) {
+		end = len(nodes)
+	}
+	var endCursor *string
+	if end > start {
+		cursor := strconv.Itoa(end - 1)
+		endCursor = &cursor
+	}
+	return nodes[start:end], map[string]interface{}{
+		"hasNextPage": end < len(nodes),
+		"endCursor":   endCursor,
+	}
+}
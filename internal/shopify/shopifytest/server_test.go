@@ -0,0 +1,175 @@
+package shopifytest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/k-yomo/terraform-provider-shopify/internal/shopify"
+	"github.com/k-yomo/terraform-provider-shopify/internal/utils"
+)
+
+// newTestClient starts a new fake server and returns it with a client sending the requests to it.
+func newTestClient(t *testing.T) (*Server, *shopify.Client) {
+	t.Helper()
+	server := NewServer()
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shopifyClient, err := goshopify.NewClient(goshopify.App{}, "fake", "fake", goshopify.WithVersion("2024-07"), goshopify.WithHTTPClient(&http.Client{
+		Transport: utils.NewBaseURLTransport(serverURL, http.DefaultTransport),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, shopify.NewClient(shopifyClient)
+}
+
+// postGraphQL sends the query to the server and returns the status code and the decoded body.
+func postGraphQL(t *testing.T, server *Server, accessToken string, query string) (int, map[string]interface{}) {
+	t.Helper()
+	body, _ := json.Marshal(graphQLRequest{Query: query})
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/admin/api/2024-07/graphql.json", strings.NewReader(string(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if accessToken != "" {
+		req.Header.Set("X-Shopify-Access-Token", accessToken)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var decoded map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, decoded
+}
+
+func assertUserErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+	if !shopify.HasUserErrorCode(err, code) {
+		t.Errorf("got error %v, want a user error of %s", err, code)
+	}
+}
+
+func TestServer_handle(t *testing.T) {
+	server, _ := newTestClient(t)
+	tests := []struct {
+		name        string
+		accessToken string
+		query       string
+		wantStatus  int
+		wantError   string
+	}{
+		{
+			name:       "rejects requests without access token",
+			query:      `query metaobjectDefinitions { metaobjectDefinitions(first: 1) { nodes { id } } }`,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:        "fails operations without name",
+			accessToken: "token",
+			query:       `{ shop { name } }`,
+			wantStatus:  http.StatusOK,
+			wantError:   "operation name is required by the fake server",
+		},
+		{
+			name:        "fails unsupported operations",
+			accessToken: "token",
+			query:       `query shop { shop { name } }`,
+			wantStatus:  http.StatusOK,
+			wantError:   "operation shop is not supported by the fake server",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := postGraphQL(t, server, tt.accessToken, tt.query)
+			if status != tt.wantStatus {
+				t.Errorf("status = %d, want %d", status, tt.wantStatus)
+			}
+			if tt.wantError == "" {
+				return
+			}
+			errs, _ := body["errors"].([]interface{})
+			if len(errs) != 1 || errs[0].(map[string]interface{})["message"] != tt.wantError {
+				t.Errorf("errors = %v, want %q", body["errors"], tt.wantError)
+			}
+		})
+	}
+}
+
+func TestServer_SetFailureHook(t *testing.T) {
+	ctx := context.Background()
+	server, client := newTestClient(t)
+	input := &shopify.MetaobjectDefinitionCreateInput{
+		Type: "designer",
+		Name: "Designer",
+		FieldDefinitions: []*shopify.MetaobjectFieldDefinitionCreateInput{
+			{Key: "name", Type: "single_line_text_field"},
+		},
+	}
+
+	var operationNames []string
+	server.SetFailureHook(func(operationName string, variables json.RawMessage) error {
+		operationNames = append(operationNames, operationName)
+		return errors.New("injected failure")
+	})
+	if _, err := client.CreateMetaobjectDefinition(ctx, input); err == nil || !strings.Contains(err.Error(), "injected failure") {
+		t.Errorf("CreateMetaobjectDefinition() error = %v, want the injected failure", err)
+	}
+	if len(operationNames) != 1 || operationNames[0] != "CreateMetaobjectDefinition" {
+		t.Errorf("hook called with %v, want [CreateMetaobjectDefinition]", operationNames)
+	}
+
+	// The failed operation isn't applied.
+	server.SetFailureHook(nil)
+	definitions, err := client.ListMetaobjectDefinitions(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(definitions) != 0 {
+		t.Errorf("got %d definitions, want 0", len(definitions))
+	}
+	if _, err := client.CreateMetaobjectDefinition(ctx, input); err != nil {
+		t.Errorf("CreateMetaobjectDefinition() error = %v after clearing the hook", err)
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	nodes := []string{"a", "b", "c"}
+	after := func(cursor string) *string { return &cursor }
+	tests := []struct {
+		name            string
+		query           string
+		after           *string
+		want            []string
+		wantHasNextPage bool
+	}{
+		{name: "returns all nodes without first", query: `nodes`, want: []string{"a", "b", "c"}},
+		{name: "returns the first page", query: `nodes(first: 2)`, want: []string{"a", "b"}, wantHasNextPage: true},
+		{name: "returns the page after the cursor", query: `nodes(first: 2)`, after: after("1"), want: []string{"c"}},
+		{name: "returns no node after the last one", query: `nodes(first: 2)`, after: after("2"), want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, pageInfo := paginate(tt.query, nodes, tt.after)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("paginate() = %v, want %v", got, tt.want)
+			}
+			if pageInfo["hasNextPage"] != tt.wantHasNextPage {
+				t.Errorf("hasNextPage = %v, want %v", pageInfo["hasNextPage"], tt.wantHasNextPage)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package shopify
+
+import (
+	"context"
+	"strings"
+)
+
+type URLRedirect struct {
+	ID     string `json:"id"`
+	Path   string `json:"path"`
+	Target string `json:"target"`
+}
+
+type URLRedirectInput struct {
+	Path   string `json:"path"`
+	Target string `json:"target"`
+}
+
+type URLRedirectMutationResponse struct {
+	Result struct {
+		URLRedirect *URLRedirect `json:"urlRedirect"`
+		UserErrors  UserErrors   `json:"userErrors"`
+	} `json:"result"`
+}
+
+func (c *Client) CreateURLRedirect(ctx context.Context, input *URLRedirectInput) (*URLRedirect, error) {
+	variables := map[string]interface{}{"urlRedirect": input}
+	query := `
+mutation CreateUrlRedirect($urlRedirect: UrlRedirectInput!) {
+  result: urlRedirectCreate(urlRedirect: $urlRedirect) {
+    urlRedirect {
+      id
+      path
+      target
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp URLRedirectMutationResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.Result.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.Result.URLRedirect, nil
+}
+
+type GetURLRedirectResponse struct {
+	URLRedirect *URLRedirect `json:"urlRedirect"`
+}
+
+func (c *Client) GetURLRedirect(ctx context.Context, id string) (*URLRedirect, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query urlRedirect($id: ID!) {
+  urlRedirect(id: $id) {
+    id
+    path
+    target
+  }
+}
+`
+
+	var gqlResp GetURLRedirectResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if gqlResp.URLRedirect == nil {
+		return nil, newNotFoundError("URL redirect", id)
+	}
+	return gqlResp.URLRedirect, nil
+}
+
+type ListURLRedirectsResponse struct {
+	URLRedirects struct {
+		Nodes    []*URLRedirect `json:"nodes"`
+		PageInfo PageInfo       `json:"pageInfo"`
+	} `json:"urlRedirects"`
+}
+
+// urlRedirectPathsPerQuery is the number of paths searched by a query, to keep the search query short.
+const urlRedirectPathsPerQuery = 50
+
+// ListURLRedirectsByPaths returns the URL redirects of the paths keyed by path.
+// Paths without a redirect are not included in the map.
+// The paths are searched in batches so that shops with many redirects don't need to be listed entirely.
+func (c *Client) ListURLRedirectsByPaths(ctx context.Context, paths []string) (map[string]*URLRedirect, error) {
+	query := `
+query urlRedirects($query: String!, $after: String) {
+  urlRedirects(first: 250, after: $after, query: $query) {
+    nodes {
+      id
+      path
+      target
+    }
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+  }
+}
+`
+
+	wanted := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		wanted[path] = true
+	}
+	urlRedirects := make(map[string]*URLRedirect, len(paths))
+	for start := 0; start < len(paths); start += urlRedirectPathsPerQuery {
+		end := min(start+urlRedirectPathsPerQuery, len(paths))
+		var after *string
+		for {
+			variables := map[string]interface{}{"query": urlRedirectPathsQuery(paths[start:end]), "after": after}
+			var gqlResp ListURLRedirectsResponse
+			err := c.query(ctx, query, variables, &gqlResp)
+			if err != nil {
+				return nil, err
+			}
+			// The search isn't an exact match, e.g. it's case-insensitive.
+			for _, urlRedirect := range gqlResp.URLRedirects.Nodes {
+				if wanted[urlRedirect.Path] {
+					urlRedirects[urlRedirect.Path] = urlRedirect
+				}
+			}
+			if !gqlResp.URLRedirects.PageInfo.HasNextPage {
+				break
+			}
+			after = gqlResp.URLRedirects.PageInfo.EndCursor
+		}
+	}
+	return urlRedirects, nil
+}
+
+// urlRedirectPathsQuery returns the search query filtering URL redirects by any of the paths.
+// The paths are quoted since they may contain the special characters of the search syntax, e.g. `:`.
+func urlRedirectPathsQuery(paths []string) string {
+	escaper := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	terms := make([]string, 0, len(paths))
+	for _, path := range paths {
+		terms = append(terms, `path:"`+escaper.Replace(path)+`"`)
+	}
+	return strings.Join(terms, " OR ")
+}
+
+func (c *Client) UpdateURLRedirect(ctx context.Context, id string, input *URLRedirectInput) (*URLRedirect, error) {
+	variables := map[string]interface{}{"id": id, "urlRedirect": input}
+	query := `
+mutation UpdateUrlRedirect($id: ID!, $urlRedirect: UrlRedirectInput!) {
+  result: urlRedirectUpdate(id: $id, urlRedirect: $urlRedirect) {
+    urlRedirect {
+      id
+      path
+      target
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp URLRedirectMutationResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.Result.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.Result.URLRedirect, nil
+}
+
+func (c *Client) DeleteURLRedirect(ctx context.Context, id string) error {
+	variables := map[string]interface{}{"id": id}
+	query := `
+mutation DeleteUrlRedirect($id: ID!) {
+  urlRedirectDelete(id: $id) {
+    deletedUrlRedirectId
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	type DeleteURLRedirectResponse struct {
+		URLRedirectDelete struct {
+			DeletedURLRedirectID string     `json:"deletedUrlRedirectId"`
+			UserErrors           UserErrors `json:"userErrors"`
+		} `json:"urlRedirectDelete"`
+	}
+	var gqlResp DeleteURLRedirectResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	if err := gqlResp.URLRedirectDelete.UserErrors.Error(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestURLRedirectPathsQuery(t *testing.T) {
+	tests := []struct {
+		paths []string
+		want  string
+	}{
+		{paths: []string{"/old"}, want: `path:"/old"`},
+		{paths: []string{"/a", "/b"}, want: `path:"/a" OR path:"/b"`},
+		{paths: []string{"/collections/sale OR /a:b"}, want: `path:"/collections/sale OR /a:b"`},
+		{paths: []string{`/say-"hi"`}, want: `path:"/say-\"hi\""`},
+		{paths: []string{`/back\slash`}, want: `path:"/back\\slash"`},
+	}
+	for _, tt := range tests {
+		if got := urlRedirectPathsQuery(tt.paths); got != tt.want {
+			t.Errorf("urlRedirectPathsQuery(%q) = %s, want %s", tt.paths, got, tt.want)
+		}
+	}
+}
+
+func TestClient_ListURLRedirectsByPaths(t *testing.T) {
+	var paths []string
+	for i := 0; i < urlRedirectPathsPerQuery*2+1; i++ {
+		paths = append(paths, fmt.Sprintf("/old-%d", i))
+	}
+
+	var queries []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables struct {
+				Query string  `json:"query"`
+				After *string `json:"after"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+		}
+		queries = append(queries, req.Variables.Query)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		// The first batch has two pages, including a redirect which isn't an exact match.
+		case strings.Contains(req.Variables.Query, `path:"/old-0"`) && req.Variables.After == nil:
+			fmt.Fprint(w, `{"data": {"urlRedirects": {
+  "nodes": [{"id": "gid://shopify/UrlRedirect/1", "path": "/old-0", "target": "/new-0"}, {"id": "gid://shopify/UrlRedirect/2", "path": "/OLD-1", "target": "/new-1"}],
+  "pageInfo": {"hasNextPage": true, "endCursor": "cursor-2"}
+}}}`)
+		case strings.Contains(req.Variables.Query, `path:"/old-0"`):
+			fmt.Fprint(w, `{"data": {"urlRedirects": {
+  "nodes": [{"id": "gid://shopify/UrlRedirect/3", "path": "/old-2", "target": "/new-2"}],
+  "pageInfo": {"hasNextPage": false}
+}}}`)
+		case strings.Contains(req.Variables.Query, `path:"/old-100"`):
+			fmt.Fprint(w, `{"data": {"urlRedirects": {
+  "nodes": [{"id": "gid://shopify/UrlRedirect/4", "path": "/old-100", "target": "/new-100"}],
+  "pageInfo": {"hasNextPage": false}
+}}}`)
+		default:
+			fmt.Fprint(w, `{"data": {"urlRedirects": {"nodes": [], "pageInfo": {"hasNextPage": false}}}}`)
+		}
+	})
+
+	urlRedirects, err := client.ListURLRedirectsByPaths(context.Background(), paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 3 batches, and the first batch has 2 pages.
+	if len(queries) != 4 {
+		t.Fatalf("sent %d requests, want 4", len(queries))
+	}
+	for _, query := range queries {
+		if terms := strings.Count(query, "path:"); terms > urlRedirectPathsPerQuery {
+			t.Errorf("query has %d paths, want at most %d", terms, urlRedirectPathsPerQuery)
+		}
+	}
+	want := map[string]string{"/old-0": "/new-0", "/old-2": "/new-2", "/old-100": "/new-100"}
+	if len(urlRedirects) != len(want) {
+		t.Errorf("got %d redirects, want %d: %v", len(urlRedirects), len(want), urlRedirects)
+	}
+	for path, target := range want {
+		if urlRedirect, ok := urlRedirects[path]; !ok || urlRedirect.Target != target {
+			t.Errorf("redirect of %s = %v, want the target %s", path, urlRedirect, target)
+		}
+	}
+}
+
+func TestClient_ListURLRedirectsByPaths_noPaths(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request")
+	})
+	urlRedirects, err := client.ListURLRedirectsByPaths(context.Background(), nil)
+	if err != nil || len(urlRedirects) != 0 {
+		t.Errorf("ListURLRedirectsByPaths() = %v, %v, want no redirects", urlRedirects, err)
+	}
+}
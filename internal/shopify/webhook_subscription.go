@@ -0,0 +1,184 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+)
+
+type WebhookSubscription struct {
+	ID                  string                       `json:"id"`
+	Topic               string                       `json:"topic"`
+	Format              string                       `json:"format"`
+	IncludeFields       []string                     `json:"includeFields"`
+	MetafieldNamespaces []string                     `json:"metafieldNamespaces"`
+	Filter              *string                      `json:"filter"`
+	Endpoint            *WebhookSubscriptionEndpoint `json:"endpoint"`
+}
+
+// WebhookSubscriptionEndpoint is one of WebhookHttpEndpoint, WebhookEventBridgeEndpoint and WebhookPubSubEndpoint.
+type WebhookSubscriptionEndpoint struct {
+	TypeName      string `json:"__typename"`
+	CallbackURL   string `json:"callbackUrl,omitempty"`
+	ARN           string `json:"arn,omitempty"`
+	PubSubProject string `json:"pubSubProject,omitempty"`
+	PubSubTopic   string `json:"pubSubTopic,omitempty"`
+}
+
+// WebhookSubscriptionInput represents WebhookSubscriptionInput, EventBridgeWebhookSubscriptionInput
+// or PubSubWebhookSubscriptionInput depending on which endpoint field is set.
+type WebhookSubscriptionInput struct {
+	CallbackURL         string   `json:"callbackUrl,omitempty"`
+	ARN                 string   `json:"arn,omitempty"`
+	PubSubProject       string   `json:"pubSubProject,omitempty"`
+	PubSubTopic         string   `json:"pubSubTopic,omitempty"`
+	Format              string   `json:"format,omitempty"`
+	IncludeFields       []string `json:"includeFields"`
+	MetafieldNamespaces []string `json:"metafieldNamespaces"`
+	Filter              *string  `json:"filter,omitempty"`
+}
+
+// mutationNames returns the mutation name prefix and the input type name for the endpoint of the input.
+func (i *WebhookSubscriptionInput) mutationNames() (string, string) {
+	switch {
+	case i.ARN != "":
+		return "eventBridgeWebhookSubscription", "EventBridgeWebhookSubscriptionInput"
+	case i.PubSubProject != "" || i.PubSubTopic != "":
+		return "pubSubWebhookSubscription", "PubSubWebhookSubscriptionInput"
+	default:
+		return "webhookSubscription", "WebhookSubscriptionInput"
+	}
+}
+
+const webhookSubscriptionFields = `
+      id
+      topic
+      format
+      includeFields
+      metafieldNamespaces
+      filter
+      endpoint {
+        __typename
+        ... on WebhookHttpEndpoint {
+          callbackUrl
+        }
+        ... on WebhookEventBridgeEndpoint {
+          arn
+        }
+        ... on WebhookPubSubEndpoint {
+          pubSubProject
+          pubSubTopic
+        }
+      }
+`
+
+type WebhookSubscriptionMutationResponse struct {
+	Result struct {
+		WebhookSubscription *WebhookSubscription `json:"webhookSubscription"`
+		UserErrors          UserErrors           `json:"userErrors"`
+	} `json:"result"`
+}
+
+func (c *Client) CreateWebhookSubscription(ctx context.Context, topic string, input *WebhookSubscriptionInput) (*WebhookSubscription, error) {
+	variables := map[string]interface{}{"topic": topic, "webhookSubscription": input}
+	mutationPrefix, inputType := input.mutationNames()
+	query := fmt.Sprintf(`
+mutation CreateWebhookSubscription($topic: WebhookSubscriptionTopic!, $webhookSubscription: %s!) {
+  result: %sCreate(topic: $topic, webhookSubscription: $webhookSubscription) {
+    webhookSubscription {%s    }
+    userErrors {
+      field
+      message
+    }
+  }
+}`, inputType, mutationPrefix, webhookSubscriptionFields)
+
+	var gqlResp WebhookSubscriptionMutationResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.Result.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.Result.WebhookSubscription, nil
+}
+
+type GetWebhookSubscriptionResponse struct {
+	WebhookSubscription *WebhookSubscription `json:"webhookSubscription"`
+}
+
+func (c *Client) GetWebhookSubscription(ctx context.Context, id string) (*WebhookSubscription, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query webhookSubscription($id: ID!) {
+  webhookSubscription(id: $id) {` + webhookSubscriptionFields + `  }
+}
+`
+
+	var gqlResp GetWebhookSubscriptionResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if gqlResp.WebhookSubscription == nil {
+		return nil, newNotFoundError("webhook subscription", id)
+	}
+	return gqlResp.WebhookSubscription, nil
+}
+
+// UpdateWebhookSubscription updates the webhook subscription.
+// The endpoint type of the input must be the same as the existing subscription.
+func (c *Client) UpdateWebhookSubscription(ctx context.Context, id string, input *WebhookSubscriptionInput) (*WebhookSubscription, error) {
+	variables := map[string]interface{}{"id": id, "webhookSubscription": input}
+	mutationPrefix, inputType := input.mutationNames()
+	query := fmt.Sprintf(`
+mutation UpdateWebhookSubscription($id: ID!, $webhookSubscription: %s!) {
+  result: %sUpdate(id: $id, webhookSubscription: $webhookSubscription) {
+    webhookSubscription {%s    }
+    userErrors {
+      field
+      message
+    }
+  }
+}`, inputType, mutationPrefix, webhookSubscriptionFields)
+
+	var gqlResp WebhookSubscriptionMutationResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.Result.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.Result.WebhookSubscription, nil
+}
+
+func (c *Client) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	variables := map[string]interface{}{"id": id}
+	query := `
+mutation DeleteWebhookSubscription($id: ID!) {
+  webhookSubscriptionDelete(id: $id) {
+    deletedWebhookSubscriptionId
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+	type DeleteWebhookSubscriptionResponse struct {
+		WebhookSubscriptionDelete struct {
+			DeletedWebhookSubscriptionID string     `json:"deletedWebhookSubscriptionId"`
+			UserErrors                   UserErrors `json:"userErrors"`
+		} `json:"webhookSubscriptionDelete"`
+	}
+	var gqlResp DeleteWebhookSubscriptionResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	if err := gqlResp.WebhookSubscriptionDelete.UserErrors.Error(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -4,82 +4,306 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
-	"net/http/httputil"
+	"net/url"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-const logReqMsg = `%s API Request Details:
----[ REQUEST ]---------------------------------------
-%s
------------------------------------------------------`
+// maskedValue replaces the values of the sensitive headers and fields in the logs.
+const maskedValue = "***"
 
-const logRespMsg = `%s API Response Details:
----[ RESPONSE ]--------------------------------------
-%s
------------------------------------------------------`
+// defaultSensitiveHeaders are the headers carrying the credentials of the Shopify APIs.
+var defaultSensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Proxy-Authorization",
+	"Set-Cookie",
+	"X-Shopify-Access-Token",
+	"X-Shopify-Storefront-Access-Token",
+}
+
+// defaultSensitiveFields are the JSON fields and the form parameters carrying tokens and OAuth secrets.
+var defaultSensitiveFields = []string{
+	"access_token",
+	"accessToken",
+	"api_secret_key",
+	"client_secret",
+	"refresh_token",
+}
 
-// Code from below is basically copied from the following logging helper
+// Code from below is based on the following logging helper
 // (need to copy to mask secrets)
 // https://github.com/hashicorp/terraform-plugin-sdk/blob/45133e6e2aebbe0aca05427cbcd360f968979e98/helper/logging/transport.go#L12
 type debugTransport struct {
-	name      string
-	transport http.RoundTripper
+	name             string
+	transport        http.RoundTripper
+	sensitiveHeaders map[string]bool
+	sensitiveFields  map[string]bool
+}
+
+type DebugTransportOption func(t *debugTransport)
+
+// WithSensitiveHeaders masks the values of the headers in addition to the default ones.
+func WithSensitiveHeaders(names ...string) DebugTransportOption {
+	return func(t *debugTransport) {
+		for _, name := range names {
+			t.sensitiveHeaders[http.CanonicalHeaderKey(name)] = true
+		}
+	}
+}
+
+// WithSensitiveFields masks the values of the JSON fields and the form parameters in addition to the default ones.
+func WithSensitiveFields(names ...string) DebugTransportOption {
+	return func(t *debugTransport) {
+		for _, name := range names {
+			t.sensitiveFields[strings.ToLower(name)] = true
+		}
+	}
 }
 
-func NewDebugTransport(t http.RoundTripper) *debugTransport {
-	return &debugTransport{name: "Shopify", transport: t}
+func NewDebugTransport(t http.RoundTripper, opts ...DebugTransportOption) *debugTransport {
+	transport := &debugTransport{
+		name:             "Shopify",
+		transport:        t,
+		sensitiveHeaders: map[string]bool{},
+		sensitiveFields:  map[string]bool{},
+	}
+	WithSensitiveHeaders(defaultSensitiveHeaders...)(transport)
+	WithSensitiveFields(defaultSensitiveFields...)(transport)
+	for _, opt := range opts {
+		opt(transport)
+	}
+	return transport
 }
 
 func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	ctx := req.Context()
-	reqData, err := httputil.DumpRequestOut(req, true)
-	if err == nil {
-		tflog.Debug(ctx, fmt.Sprintf(logReqMsg, t.name, prettyPrintJsonLines(reqData)))
-	} else {
-		tflog.Error(ctx, fmt.Sprintf("%s API Request error: %#v", t.name, err))
+
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{
+		"tf_http_op_type":    "request",
+		"tf_http_req_method": req.Method,
+		"tf_http_req_uri":    t.maskURL(req.URL),
+		"tf_http_req_body":   t.maskBody(reqBody, req.Header.Get("Content-Type")),
+	}
+	for name, value := range t.maskHeaders(req.Header) {
+		fields["tf_http_req_header_"+name] = value
+	}
+	operationName := graphQLOperationName(reqBody)
+	if operationName != "" {
+		fields["shopify_graphql_operation"] = operationName
 	}
+	tflog.Debug(ctx, fmt.Sprintf("Sending %s API request", t.name), fields)
 
+	start := time.Now()
 	resp, err := t.transport.RoundTrip(req)
+	duration := time.Since(start)
 	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("%s API request error", t.name), map[string]interface{}{
+			"tf_http_op_type":           "response",
+			"tf_http_duration_ms":       duration.Milliseconds(),
+			"shopify_graphql_operation": operationName,
+			"error":                     err.Error(),
+		})
 		return resp, err
 	}
 
-	respData, err := httputil.DumpResponse(resp, true)
-	if err == nil {
-		tflog.Debug(ctx, fmt.Sprintf(logRespMsg, t.name, prettyPrintJsonLines(respData)))
-	} else {
-		tflog.Error(ctx, fmt.Sprintf("%s API Response error: %#v", t.name, err))
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	fields = map[string]interface{}{
+		"tf_http_op_type":         "response",
+		"tf_http_res_status_code": resp.StatusCode,
+		"tf_http_duration_ms":     duration.Milliseconds(),
+		"tf_http_res_body":        t.maskBody(respBody, resp.Header.Get("Content-Type")),
+	}
+	for name, value := range t.maskHeaders(resp.Header) {
+		fields["tf_http_res_header_"+name] = value
+	}
+	if operationName != "" {
+		fields["shopify_graphql_operation"] = operationName
+	}
+	if cost := graphQLQueryCost(respBody); cost != nil {
+		fields["shopify_graphql_requested_query_cost"] = cost.RequestedQueryCost
+		if cost.ActualQueryCost != nil {
+			fields["shopify_graphql_actual_query_cost"] = *cost.ActualQueryCost
+		}
+		fields["shopify_graphql_currently_available"] = cost.ThrottleStatus.CurrentlyAvailable
 	}
+	tflog.Debug(ctx, fmt.Sprintf("Received %s API response", t.name), fields)
 
 	return resp, nil
 }
 
-// prettyPrintJsonLines iterates through a []byte line-by-line,
-// transforming any lines that are complete json into pretty-printed json.
-func prettyPrintJsonLines(b []byte) string {
-	parts := strings.Split(string(b), "\n")
-	for i, p := range parts {
-		if b := []byte(p); json.Valid(b) {
-			var out bytes.Buffer
-			if err := json.Indent(&out, b, "", " "); err != nil {
-				continue
-			}
-			parts[i] = out.String()
+// readBody reads the body and replaces it with a copy, so that it can be read again.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	b, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+func (t *debugTransport) maskHeaders(header http.Header) map[string]string {
+	masked := make(map[string]string, len(header))
+	for name, values := range header {
+		if t.sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+			masked[name] = maskedValue
+			continue
+		}
+		masked[name] = strings.Join(values, ", ")
+	}
+	return masked
+}
+
+func (t *debugTransport) maskURL(u *url.URL) string {
+	query := u.Query()
+	if len(query) == 0 {
+		return u.String()
+	}
+	masked := *u
+	masked.RawQuery = t.maskValues(query).Encode()
+	return masked.String()
+}
+
+func (t *debugTransport) maskValues(values url.Values) url.Values {
+	for name := range values {
+		if t.sensitiveFields[strings.ToLower(name)] {
+			values[name] = []string{maskedValue}
 		}
-		// Mask following header values
-		// X-Algolia-Api-Key
-		// X-Algolia-Application-Id
-		if strings.Contains(strings.ToLower(p), "x-algolia") {
-			kv := strings.Split(p, ": ")
-			if len(kv) != 2 {
+	}
+	return values
+}
+
+// maskBody masks the sensitive fields of JSON and form bodies. Other bodies are logged as they are.
+func (t *debugTransport) maskBody(body []byte, contentType string) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return maskedValue
+		}
+		return t.maskValues(values).Encode()
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+	masked, err := json.Marshal(t.maskJSON(v))
+	if err != nil {
+		return maskedValue
+	}
+	return string(masked)
+}
+
+func (t *debugTransport) maskJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if t.sensitiveFields[strings.ToLower(key)] {
+				v[key] = maskedValue
 				continue
 			}
-			kv[1] = strings.Repeat("*", len(kv[1]))
-			parts[i] = strings.Join(kv, ": ")
+			v[key] = t.maskJSON(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = t.maskJSON(value)
 		}
 	}
-	return strings.Join(parts, "\n")
+	return v
+}
+
+var graphQLOperationNameRegex = regexp.MustCompile(`^\s*(?:query|mutation)\s+(\w+)`)
+
+// graphQLOperationName returns the operation name of the GraphQL request body, or empty if it's not a GraphQL request.
+func graphQLOperationName(body []byte) string {
+	var req struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		return ""
+	}
+	matches := graphQLOperationNameRegex.FindStringSubmatch(req.Query)
+	if matches == nil {
+		return ""
+	}
+	return matches[1]
+}
+
+// graphQLQueryCost returns the cost reported in the GraphQL response body, or nil if it's not reported.
+func graphQLQueryCost(body []byte) *graphQLCost {
+	var resp struct {
+		Extensions struct {
+			Cost *graphQLCost `json:"cost"`
+		} `json:"extensions"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil
+	}
+	return resp.Extensions.Cost
+}
+
+// graphQLCost is the subset of `extensions.cost` of GraphQL responses.
+// The shopify package can't be imported here since it depends on this package.
+type graphQLCost struct {
+	RequestedQueryCost float64  `json:"requestedQueryCost"`
+	ActualQueryCost    *float64 `json:"actualQueryCost"`
+	ThrottleStatus     struct {
+		CurrentlyAvailable float64 `json:"currentlyAvailable"`
+	} `json:"throttleStatus"`
+}
+
+// baseURLTransport sends the requests to the base URL instead of the shop's domain,
+// since go-shopify always builds the request URL from the shop name.
+type baseURLTransport struct {
+	baseURL   *url.URL
+	transport http.RoundTripper
+}
+
+func NewBaseURLTransport(baseURL *url.URL, t http.RoundTripper) *baseURLTransport {
+	return &baseURLTransport{baseURL: baseURL, transport: t}
+}
+
+func (t *baseURLTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.baseURL.Scheme
+	req.URL.Host = t.baseURL.Host
+	req.URL.Path = strings.TrimSuffix(t.baseURL.Path, "/") + req.URL.Path
+	req.Host = t.baseURL.Host
+	return t.transport.RoundTrip(req)
+}
+
+// headerTransport sets the headers to every request.
+type headerTransport struct {
+	headers   map[string]string
+	transport http.RoundTripper
+}
+
+func NewHeaderTransport(headers map[string]string, t http.RoundTripper) *headerTransport {
+	return &headerTransport{headers: headers, transport: t}
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for name, value := range t.headers {
+		req.Header.Set(name, value)
+	}
+	return t.transport.RoundTrip(req)
 }
@@ -0,0 +1,170 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestDebugTransport_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name            string
+		opts            []DebugTransportOption
+		reqURL          string
+		reqHeader       map[string]string
+		reqBody         string
+		respContentType string
+		respBody        string
+		secrets         []string
+		wantFields      map[string]interface{}
+	}{
+		{
+			name:      "access token header",
+			reqURL:    "https://test.myshopify.com/admin/api/2024-07/graphql.json",
+			reqHeader: map[string]string{"X-Shopify-Access-Token": "shpat_secret", "Content-Type": "application/json"},
+			reqBody:   `{"query":"query shop { shop { name } }"}`,
+			secrets:   []string{"shpat_secret"},
+			wantFields: map[string]interface{}{
+				"tf_http_req_header_X-Shopify-Access-Token": "***",
+				"tf_http_req_header_Content-Type":           "application/json",
+				"shopify_graphql_operation":                 "shop",
+			},
+		},
+		{
+			name:      "form client secret",
+			reqURL:    "https://test.myshopify.com/admin/oauth/access_token",
+			reqHeader: map[string]string{"Content-Type": "application/x-www-form-urlencoded"},
+			reqBody:   "client_id=app&client_secret=shpss_secret&code=abc",
+			secrets:   []string{"shpss_secret"},
+			wantFields: map[string]interface{}{
+				"tf_http_req_body": "client_id=app&client_secret=%2A%2A%2A&code=abc",
+			},
+		},
+		{
+			name:            "nested JSON access token",
+			reqURL:          "https://test.myshopify.com/admin/oauth/access_token",
+			reqHeader:       map[string]string{"Content-Type": "application/json"},
+			reqBody:         `{"client_id":"app","client_secret":"shpss_secret"}`,
+			respContentType: "application/json",
+			respBody:        `{"data":{"tokens":[{"access_token":"shpat_secret","scope":"read_products"}],"refresh":{"accessToken":"shpat_other"}}}`,
+			secrets:         []string{"shpss_secret", "shpat_secret", "shpat_other"},
+			wantFields: map[string]interface{}{
+				"tf_http_req_body": `{"client_id":"app","client_secret":"***"}`,
+				"tf_http_res_body": `{"data":{"refresh":{"accessToken":"***"},"tokens":[{"access_token":"***","scope":"read_products"}]}}`,
+			},
+		},
+		{
+			name:      "query parameter",
+			reqURL:    "https://test.myshopify.com/admin/api/2024-07/shop.json?access_token=shpat_secret&fields=name",
+			reqHeader: map[string]string{},
+			secrets:   []string{"shpat_secret"},
+			wantFields: map[string]interface{}{
+				"tf_http_req_uri": "https://test.myshopify.com/admin/api/2024-07/shop.json?access_token=%2A%2A%2A&fields=name",
+			},
+		},
+		{
+			name: "configured sensitive fields and headers",
+			opts: []DebugTransportOption{
+				WithSensitiveFields("Email"),
+				WithSensitiveHeaders("x-proxy-key"),
+			},
+			reqURL:          "https://test.myshopify.com/admin/api/2024-07/graphql.json",
+			reqHeader:       map[string]string{"Content-Type": "application/json", "X-Proxy-Key": "proxy_secret"},
+			reqBody:         `{"query":"mutation customerCreate($input: CustomerInput!) { customerCreate(input: $input) { customer { id } } }","variables":{"input":{"email":"user@example.com"}}}`,
+			respContentType: "application/json",
+			respBody:        `{"data":{"customerCreate":{"customer":{"id":"gid://shopify/Customer/1","email":"user@example.com"}}}}`,
+			secrets:         []string{"user@example.com", "proxy_secret"},
+			wantFields: map[string]interface{}{
+				"tf_http_req_header_X-Proxy-Key": "***",
+				"tf_http_req_body":               `{"query":"mutation customerCreate($input: CustomerInput!) { customerCreate(input: $input) { customer { id } } }","variables":{"input":{"email":"***"}}}`,
+				"tf_http_res_body":               `{"data":{"customerCreate":{"customer":{"email":"***","id":"gid://shopify/Customer/1"}}}}`,
+				"shopify_graphql_operation":      "customerCreate",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			ctx := tflogtest.RootLogger(context.Background(), &logs)
+
+			var sentBody string
+			transport := NewDebugTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				if req.Body != nil {
+					b, err := io.ReadAll(req.Body)
+					if err != nil {
+						return nil, err
+					}
+					sentBody = string(b)
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Content-Type": []string{tt.respContentType}},
+					Body:       io.NopCloser(strings.NewReader(tt.respBody)),
+				}, nil
+			}), tt.opts...)
+
+			var reqBody io.Reader
+			if tt.reqBody != "" {
+				reqBody = strings.NewReader(tt.reqBody)
+			}
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, tt.reqURL, reqBody)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for name, value := range tt.reqHeader {
+				req.Header.Set(name, value)
+			}
+			resp, err := transport.RoundTrip(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			respBody, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The bodies are passed through as they are, only the logs are masked.
+			if sentBody != tt.reqBody {
+				t.Errorf("sent body = %q, want %q", sentBody, tt.reqBody)
+			}
+			if string(respBody) != tt.respBody {
+				t.Errorf("response body = %q, want %q", respBody, tt.respBody)
+			}
+
+			for _, secret := range tt.secrets {
+				if strings.Contains(logs.String(), secret) {
+					t.Errorf("%q is logged:\n%s", secret, logs.String())
+				}
+			}
+			entries, err := tflogtest.MultilineJSONDecode(&logs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(entries) != 2 {
+				t.Fatalf("got %d log entries, want 2", len(entries))
+			}
+			fields := map[string]interface{}{}
+			for _, entry := range entries {
+				for key, value := range entry {
+					fields[key] = value
+				}
+			}
+			for key, want := range tt.wantFields {
+				if got := fields[key]; got != want {
+					t.Errorf("%s = %v, want %v", key, got, want)
+				}
+			}
+		})
+	}
+}
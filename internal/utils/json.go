@@ -0,0 +1,19 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// JSONEqual reports whether a and b are valid JSON documents with the same content,
+// ignoring whitespace and object key order.
+func JSONEqual(a, b string) bool {
+	var av, bv interface{}
+	if err := json.Unmarshal([]byte(a), &av); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &bv); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(av, bv)
+}